@@ -21,7 +21,7 @@ func main() {
 	fmt.Println("The determinant of [[2, 1, 2], [4, 1, 6], [2, 2, 3]] is", determinant.Value)
 	chanceOfSuccess, isRead := new(big.Float).SetString("0.20")
 	if !isRead {
-		fmt.Sprintln("Failed to convert", chanceOfSuccess, "to string")
+		fmt.Println("Failed to convert", chanceOfSuccess, "to string")
 		return
 	}
 	formattedChance := new(big.Float)
@@ -32,5 +32,5 @@ func main() {
 	if probabilityErr != nil {
 		panic(probabilityErr)
 	}
-	fmt.Sprintln("With a chance of", formattedChance, "the chance of", successes, "successes in", trials, "trials is", probability)
+	fmt.Println("With a chance of", formattedChance, "the chance of", successes, "successes in", trials, "trials is", &probability)
 }
@@ -9,9 +9,12 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
+	"unicode"
 
 	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
 	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/regression"
 )
 
 //go:embed templates/*.html
@@ -26,6 +29,14 @@ var cdfTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html",
 
 var pvalueTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html", "templates/pvalue.html"))
 
+var poissonTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html", "templates/poisson.html"))
+
+var quantileTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html", "templates/quantile.html"))
+
+var intervalTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html", "templates/interval.html"))
+
+var regressionTmpl = template.Must(template.ParseFS(templateFS, "templates/base.html", "templates/regression.html"))
+
 type formData struct {
 	P, N, K   string
 	Error     string
@@ -58,6 +69,77 @@ type pvalueData struct {
 	ActiveTab string
 }
 
+type poissonData struct {
+	Lambda, K      string
+	Error          string
+	Probability    string
+	ProbabilityPct string
+	Cumulative     string
+	CumulativePct  string
+	Terms          []termRow
+	ActiveTab      string
+}
+
+type quantileData struct {
+	P, N, Alpha   string
+	Error         string
+	K             string
+	Cumulative    string
+	CumulativePct string
+	ActiveTab     string
+}
+
+type intervalRow struct {
+	Method string
+	Lower  string
+	Upper  string
+}
+
+type intervalData struct {
+	N, K       string
+	Confidence string
+	Error      string
+	Intervals  []intervalRow
+	ActiveTab  string
+}
+
+type coefficientRow struct {
+	Term          string
+	Estimate      string
+	StandardError string
+	T             string
+	P             string
+}
+
+type residualRow struct {
+	X, Y     string
+	Fitted   string
+	Residual string
+}
+
+type curveRow struct {
+	Method   string
+	A, B     string
+	SSE      string
+	RSquared string
+}
+
+type regressionData struct {
+	Points                string
+	Model                 string
+	Refine                bool
+	Error                 string
+	Equation              string
+	CurveFits             []curveRow
+	Coefficients          []coefficientRow
+	RSquared              string
+	AdjustedRSquared      string
+	ResidualStandardError string
+	DegreesOfFreedom      int64
+	Residuals             []residualRow
+	ActiveTab             string
+}
+
 func formHandler(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
 		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
@@ -207,6 +289,284 @@ func pvalueCalculateHandler(w http.ResponseWriter, r *http.Request) {
 	pvalueTmpl.Execute(w, d) //nolint:errcheck
 }
 
+func poissonFormHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	poissonTmpl.Execute(w, poissonData{ActiveTab: "poisson"}) //nolint:errcheck
+}
+
+func poissonCalculateHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		poissonTmpl.Execute(w, poissonData{Error: "Could not parse form.", ActiveTab: "poisson"}) //nolint:errcheck
+		return
+	}
+	d := poissonData{Lambda: r.FormValue("lambda"), K: r.FormValue("k"), ActiveTab: "poisson"}
+	lambda, ok := new(big.Float).SetString(d.Lambda)
+	if !ok {
+		d.Error = "Invalid value for λ — must be a non-negative decimal number."
+		poissonTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	k, err := strconv.ParseInt(d.K, 10, 64)
+	if err != nil {
+		d.Error = "Invalid value for k — must be a whole number."
+		poissonTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	cumulative, terms, calcErr := calculator.CumulativePoissonProbability(lambda, k)
+	if calcErr != nil {
+		d.Error = calcErr.Error()
+		poissonTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	probability := terms[len(terms)-1]
+	d.Probability = bu.ToStr(&probability, 10)
+	d.ProbabilityPct = bu.ToStr(bu.PrecFloat().Mul(&probability, big.NewFloat(100)), 4)
+	d.Cumulative = bu.ToStr(&cumulative, 10)
+	d.CumulativePct = bu.ToStr(bu.PrecFloat().Mul(&cumulative, big.NewFloat(100)), 4)
+	runningSum := bu.PrecFloat().SetInt64(0)
+	d.Terms = make([]termRow, len(terms))
+	for i, term := range terms {
+		prob := new(big.Float).Copy(&term)
+		runningSum.Add(runningSum, prob)
+		d.Terms[i] = termRow{
+			K:           strconv.FormatInt(int64(i), 10),
+			Probability: bu.ToStr(prob, 6),
+			Cumulative:  bu.ToStr(runningSum, 6),
+		}
+	}
+	poissonTmpl.Execute(w, d) //nolint:errcheck
+}
+
+func quantileFormHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	quantileTmpl.Execute(w, quantileData{ActiveTab: "quantile"}) //nolint:errcheck
+}
+
+func quantileCalculateHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		quantileTmpl.Execute(w, quantileData{Error: "Could not parse form.", ActiveTab: "quantile"}) //nolint:errcheck
+		return
+	}
+	d := quantileData{P: r.FormValue("p"), N: r.FormValue("n"), Alpha: r.FormValue("alpha"), ActiveTab: "quantile"}
+	p, ok := new(big.Float).SetString(d.P)
+	if !ok {
+		d.Error = "Invalid value for p — must be a decimal number between 0 and 1."
+		quantileTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	n, err := strconv.ParseInt(d.N, 10, 64)
+	if err != nil {
+		d.Error = "Invalid value for n — must be a whole number."
+		quantileTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	alpha, ok := new(big.Float).SetString(d.Alpha)
+	if !ok {
+		d.Error = "Invalid value for α — must be a decimal number between 0 and 1."
+		quantileTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	k, cumulative, calcErr := calculator.BinomialQuantile(p, n, alpha)
+	if calcErr != nil {
+		d.Error = calcErr.Error()
+		quantileTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	d.K = strconv.FormatInt(k, 10)
+	d.Cumulative = bu.ToStr(&cumulative, 10)
+	d.CumulativePct = bu.ToStr(bu.PrecFloat().Mul(&cumulative, big.NewFloat(100)), 4)
+	quantileTmpl.Execute(w, d) //nolint:errcheck
+}
+
+func intervalFormHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	intervalTmpl.Execute(w, intervalData{Confidence: "0.95", ActiveTab: "interval"}) //nolint:errcheck
+}
+
+func intervalCalculateHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		intervalTmpl.Execute(w, intervalData{Error: "Could not parse form.", ActiveTab: "interval"}) //nolint:errcheck
+		return
+	}
+	d := intervalData{N: r.FormValue("n"), K: r.FormValue("k"), Confidence: r.FormValue("confidence"), ActiveTab: "interval"}
+	n, err := strconv.ParseInt(d.N, 10, 64)
+	if err != nil {
+		d.Error = "Invalid value for n — must be a whole number."
+		intervalTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	k, err := strconv.ParseInt(d.K, 10, 64)
+	if err != nil {
+		d.Error = "Invalid value for k — must be a whole number."
+		intervalTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	confidence, ok := new(big.Float).SetString(d.Confidence)
+	if !ok {
+		d.Error = "Invalid value for confidence level — must be a decimal number between 0 and 1."
+		intervalTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	methods := []struct {
+		name     string
+		interval func(n, k int64, confidence *big.Float) (big.Float, big.Float, error)
+	}{
+		{"Clopper–Pearson", calculator.ClopperPearsonInterval},
+		{"Wilson", calculator.WilsonInterval},
+		{"Agresti–Coull", calculator.AgrestiCoullInterval},
+		{"Jeffreys", calculator.JeffreysInterval},
+	}
+	intervals := make([]intervalRow, 0, len(methods))
+	for _, method := range methods {
+		lower, upper, calcErr := method.interval(n, k, confidence)
+		if calcErr != nil {
+			d.Error = calcErr.Error()
+			intervalTmpl.Execute(w, d) //nolint:errcheck
+			return
+		}
+		intervals = append(intervals, intervalRow{Method: method.name, Lower: bu.ToStr(&lower, 6), Upper: bu.ToStr(&upper, 6)})
+	}
+	d.Intervals = intervals
+	intervalTmpl.Execute(w, d) //nolint:errcheck
+}
+
+func regressionFormHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	regressionTmpl.Execute(w, regressionData{Model: "linear", ActiveTab: "regression"}) //nolint:errcheck
+}
+
+func regressionCalculateHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		regressionTmpl.Execute(w, regressionData{Error: "Could not parse form.", Model: "linear", ActiveTab: "regression"}) //nolint:errcheck
+		return
+	}
+	d := regressionData{Points: r.FormValue("points"), Model: r.FormValue("model"), Refine: r.FormValue("refine") != "", ActiveTab: "regression"}
+	if d.Model == "" {
+		d.Model = "linear"
+	}
+	if d.Model != "linear" && d.Model != "exponential" && d.Model != "power" {
+		d.Error = "Invalid value for model — must be linear, exponential or power."
+		regressionTmpl.Execute(w, d) //nolint:errcheck
+		return
+	}
+	var x, y []*big.Float
+	for index, line := range strings.Split(d.Points, "\n") {
+		fields := strings.FieldsFunc(line, func(c rune) bool { return c == ',' || unicode.IsSpace(c) })
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			d.Error = fmt.Sprintf("Invalid value on line %d — each line must be an x, y pair.", index+1)
+			regressionTmpl.Execute(w, d) //nolint:errcheck
+			return
+		}
+		xValue, xOk := bu.PrecFloat().SetString(fields[0])
+		yValue, yOk := bu.PrecFloat().SetString(fields[1])
+		if !xOk || !yOk || xValue.IsInf() || yValue.IsInf() {
+			d.Error = fmt.Sprintf("Invalid value on line %d — x and y must be decimal numbers.", index+1)
+			regressionTmpl.Execute(w, d) //nolint:errcheck
+			return
+		}
+		x = append(x, xValue)
+		y = append(y, yValue)
+	}
+	var calcErr error
+	if d.Model == "linear" {
+		calcErr = linearResult(&d, x, y)
+	} else {
+		calcErr = curveResult(&d, x, y)
+	}
+	if calcErr != nil {
+		d.Error = calcErr.Error()
+		d.Coefficients, d.CurveFits, d.Residuals = nil, nil, nil
+	}
+	regressionTmpl.Execute(w, d) //nolint:errcheck
+}
+
+func linearResult(d *regressionData, x, y []*big.Float) error {
+	fit, err := regression.SimpleLinear(x, y)
+	if err != nil {
+		return err
+	}
+	for j, term := range []string{"Intercept", "Slope"} {
+		row := coefficientRow{Term: term, Estimate: bu.ToStr(fit.Coefficients[j], 6), StandardError: bu.ToStr(fit.StandardErrors[j], 6), T: "—", P: "—"}
+		if fit.TStatistics != nil {
+			row.T = bu.ToStr(fit.TStatistics[j], 4)
+			row.P = bu.ToStr(fit.PValues[j], 6)
+		}
+		d.Coefficients = append(d.Coefficients, row)
+	}
+	d.RSquared, d.AdjustedRSquared = "—", "—"
+	if fit.RSquared != nil {
+		d.RSquared = bu.ToStr(fit.RSquared, 6)
+		d.AdjustedRSquared = bu.ToStr(fit.AdjustedRSquared, 6)
+	}
+	d.ResidualStandardError = bu.ToStr(fit.ResidualStandardError, 6)
+	d.DegreesOfFreedom = fit.DegreesOfFreedom
+	for i := range x {
+		d.Residuals = append(d.Residuals, residualRow{
+			X: x[i].Text('g', 10), Y: y[i].Text('g', 10),
+			Fitted: bu.ToStr(fit.Fitted[i], 6), Residual: bu.ToStr(fit.Residuals[i], 6),
+		})
+	}
+	return nil
+}
+
+// curveResult lists the log-linear fit and, when asked for, the refined one, with residuals from the last of them
+func curveResult(d *regressionData, x, y []*big.Float) error {
+	curve := regression.Exponential[*big.Float]
+	d.Equation = "y = a·e^(bx)"
+	if d.Model == "power" {
+		curve = regression.Power[*big.Float]
+		d.Equation = "y = a·x^b"
+	}
+	result, err := curve(x, y, regression.CurveFitOptions{Refine: d.Refine})
+	if err != nil {
+		return err
+	}
+	fits := []struct {
+		method string
+		fit    *regression.CurveFit
+	}{{"Log-linear least squares", &result.Linearised}}
+	if result.Refined != nil {
+		method := fmt.Sprintf("Nonlinear least squares (%d iterations)", result.Iterations)
+		if !result.Converged {
+			method = fmt.Sprintf("Nonlinear least squares (stopped after %d iterations)", result.Iterations)
+		}
+		fits = append(fits, struct {
+			method string
+			fit    *regression.CurveFit
+		}{method, result.Refined})
+	}
+	for _, entry := range fits {
+		row := curveRow{Method: entry.method, A: bu.ToStr(entry.fit.A, 6), B: bu.ToStr(entry.fit.B, 6), SSE: bu.ToStr(entry.fit.SSE, 6), RSquared: "—"}
+		if entry.fit.RSquared != nil {
+			row.RSquared = bu.ToStr(entry.fit.RSquared, 6)
+		}
+		d.CurveFits = append(d.CurveFits, row)
+	}
+	last := fits[len(fits)-1].fit
+	for i := range x {
+		d.Residuals = append(d.Residuals, residualRow{
+			X: x[i].Text('g', 10), Y: y[i].Text('g', 10),
+			Fitted: bu.ToStr(last.Fitted[i], 6), Residual: bu.ToStr(last.Residuals[i], 6),
+		})
+	}
+	return nil
+}
+
 func main() {
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -223,6 +583,14 @@ func main() {
 	http.HandleFunc("/cdf/calculate", cdfCalculateHandler)
 	http.HandleFunc("/pvalue", pvalueFormHandler)
 	http.HandleFunc("/pvalue/calculate", pvalueCalculateHandler)
+	http.HandleFunc("/poisson", poissonFormHandler)
+	http.HandleFunc("/poisson/calculate", poissonCalculateHandler)
+	http.HandleFunc("/quantile", quantileFormHandler)
+	http.HandleFunc("/quantile/calculate", quantileCalculateHandler)
+	http.HandleFunc("/interval", intervalFormHandler)
+	http.HandleFunc("/interval/calculate", intervalCalculateHandler)
+	http.HandleFunc("/regression", regressionFormHandler)
+	http.HandleFunc("/regression/calculate", regressionCalculateHandler)
 	fmt.Printf("Listening on :%s\n", port)
 	if err := http.ListenAndServe(":"+port, nil); err != nil {
 		fmt.Fprintf(os.Stderr, "server error: %v\n", err)
@@ -1,10 +1,13 @@
 package main
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -226,3 +229,321 @@ func TestPValueCalculateHandler_calc_error_shows_error(t *testing.T) {
 		t.Errorf("expected no result on error, got:\n%s", body)
 	}
 }
+
+func TestPoissonFormHandler_GET_renders_form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/poisson", nil)
+	w := httptest.NewRecorder()
+	poissonFormHandler(w, req)
+	if w.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Result().StatusCode)
+	}
+	if !strings.Contains(w.Body.String(), "Poisson Distribution") {
+		t.Error("expected title in body")
+	}
+}
+
+func TestPoissonCalculateHandler_valid_input_shows_result_and_table(t *testing.T) {
+	form := url.Values{"lambda": {"2"}, "k": {"3"}}
+	req := httptest.NewRequest(http.MethodPost, "/poisson/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	poissonCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "0.1804470443") {
+		t.Errorf("expected P(X=k) in body, got:\n%s", body)
+	}
+	if !strings.Contains(body, "0.8571234605") {
+		t.Errorf("expected cumulative result in body, got:\n%s", body)
+	}
+	if !strings.Contains(body, "0.135335") {
+		t.Errorf("expected P(X=0) term in table, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="2"`) {
+		t.Errorf("expected lambda pre-filled, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="3"`) {
+		t.Errorf("expected k pre-filled, got:\n%s", body)
+	}
+}
+
+func TestPoissonCalculateHandler_invalid_lambda_shows_error_and_preserves_form(t *testing.T) {
+	form := url.Values{"lambda": {"abc"}, "k": {"3"}}
+	req := httptest.NewRequest(http.MethodPost, "/poisson/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	poissonCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "Invalid value for λ") {
+		t.Errorf("expected error message, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="abc"`) {
+		t.Errorf("expected lambda pre-filled on error, got:\n%s", body)
+	}
+}
+
+func TestPoissonCalculateHandler_calc_error_shows_error(t *testing.T) {
+	form := url.Values{"lambda": {"-2"}, "k": {"3"}}
+	req := httptest.NewRequest(http.MethodPost, "/poisson/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	poissonCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, `class="error"`) {
+		t.Errorf("expected error div, got:\n%s", body)
+	}
+	if strings.Contains(body, "result-value") {
+		t.Errorf("expected no result on error, got:\n%s", body)
+	}
+}
+
+func TestQuantileFormHandler_GET_renders_form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/quantile", nil)
+	w := httptest.NewRecorder()
+	quantileFormHandler(w, req)
+	if w.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Result().StatusCode)
+	}
+	if !strings.Contains(w.Body.String(), "Binomial Quantile") {
+		t.Error("expected title in body")
+	}
+}
+
+func TestQuantileCalculateHandler_valid_input_shows_result(t *testing.T) {
+	form := url.Values{"p": {"0.3"}, "n": {"20"}, "alpha": {"0.95"}}
+	req := httptest.NewRequest(http.MethodPost, "/quantile/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	quantileCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, `<div class="result-value">9</div>`) {
+		t.Errorf("expected k in body, got:\n%s", body)
+	}
+	if !strings.Contains(body, "0.9520381027") {
+		t.Errorf("expected achieved cumulative probability in body, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="0.95"`) {
+		t.Errorf("expected alpha pre-filled, got:\n%s", body)
+	}
+}
+
+func TestQuantileCalculateHandler_invalid_alpha_shows_error_and_preserves_form(t *testing.T) {
+	form := url.Values{"p": {"0.3"}, "n": {"20"}, "alpha": {"abc"}}
+	req := httptest.NewRequest(http.MethodPost, "/quantile/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	quantileCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "Invalid value for α") {
+		t.Errorf("expected error message, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="abc"`) {
+		t.Errorf("expected alpha pre-filled on error, got:\n%s", body)
+	}
+}
+
+func TestQuantileCalculateHandler_calc_error_shows_error(t *testing.T) {
+	form := url.Values{"p": {"0.3"}, "n": {"20"}, "alpha": {"1.5"}}
+	req := httptest.NewRequest(http.MethodPost, "/quantile/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	quantileCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, `class="error"`) {
+		t.Errorf("expected error div, got:\n%s", body)
+	}
+	if strings.Contains(body, "result-value") {
+		t.Errorf("expected no result on error, got:\n%s", body)
+	}
+}
+
+func TestIntervalFormHandler_GET_renders_form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/interval", nil)
+	w := httptest.NewRecorder()
+	intervalFormHandler(w, req)
+	if w.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Result().StatusCode)
+	}
+	if !strings.Contains(w.Body.String(), "Proportion Confidence Intervals") {
+		t.Error("expected title in body")
+	}
+}
+
+func TestIntervalCalculateHandler_valid_input_shows_all_methods(t *testing.T) {
+	form := url.Values{"n": {"20"}, "k": {"7"}, "confidence": {"0.95"}}
+	req := httptest.NewRequest(http.MethodPost, "/interval/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	intervalCalculateHandler(w, req)
+	body := w.Body.String()
+	for _, want := range []string{"Clopper–Pearson", "Wilson", "Agresti–Coull", "Jeffreys", "0.153909", "0.592189"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in body, got:\n%s", want, body)
+		}
+	}
+	if !strings.Contains(body, `value="7"`) {
+		t.Errorf("expected k pre-filled, got:\n%s", body)
+	}
+}
+
+func TestIntervalCalculateHandler_invalid_n_shows_error_and_preserves_form(t *testing.T) {
+	form := url.Values{"n": {"abc"}, "k": {"7"}, "confidence": {"0.95"}}
+	req := httptest.NewRequest(http.MethodPost, "/interval/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	intervalCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "Invalid value for n") {
+		t.Errorf("expected error message, got:\n%s", body)
+	}
+	if !strings.Contains(body, `value="abc"`) {
+		t.Errorf("expected n pre-filled on error, got:\n%s", body)
+	}
+}
+
+func TestIntervalCalculateHandler_calc_error_shows_error(t *testing.T) {
+	form := url.Values{"n": {"5"}, "k": {"7"}, "confidence": {"0.95"}}
+	req := httptest.NewRequest(http.MethodPost, "/interval/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	intervalCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, `class="error"`) {
+		t.Errorf("expected error div, got:\n%s", body)
+	}
+	if strings.Contains(body, "distribution-table") {
+		t.Errorf("expected no result table on error, got:\n%s", body)
+	}
+}
+
+func TestRegressionFormHandler_GET_renders_form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/regression", nil)
+	w := httptest.NewRecorder()
+	regressionFormHandler(w, req)
+	if w.Result().StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %d", w.Result().StatusCode)
+	}
+	if !strings.Contains(w.Body.String(), "Regression") {
+		t.Error("expected title in body")
+	}
+}
+
+func TestRegressionCalculateHandler_valid_input_shows_fit(t *testing.T) {
+	points := "1, 2.125\n2, 3.875\n3 6.25\n4,7.75\n\n5, 10.125\n6, 12.25\n7, 13.75\n8, 16.125\n"
+	form := url.Values{"points": {points}}
+	req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	regressionCalculateHandler(w, req)
+	body := w.Body.String()
+	for _, want := range []string{"Intercept", "Slope", "1.997024", "0.044643", "0.998186", "0.807680", "0.083333"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in body, got:\n%s", want, body)
+		}
+	}
+	if !strings.Contains(body, "3 6.25") {
+		t.Errorf("expected points pre-filled, got:\n%s", body)
+	}
+}
+
+func TestRegressionCalculateHandler_invalid_line_shows_error_and_preserves_form(t *testing.T) {
+	form := url.Values{"points": {"1, 2\n2, abc\n3, 4"}}
+	req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	regressionCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "Invalid value on line 2") {
+		t.Errorf("expected error message, got:\n%s", body)
+	}
+	if !strings.Contains(body, "2, abc") {
+		t.Errorf("expected points pre-filled on error, got:\n%s", body)
+	}
+}
+
+func TestRegressionCalculateHandler_infinite_value_shows_error(t *testing.T) {
+	form := url.Values{"points": {"1, 2\n2, 3\nInf, 4\n4, 5"}}
+	req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	regressionCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, "Invalid value on line 3") {
+		t.Errorf("expected error message, got:\n%s", body)
+	}
+	if strings.Contains(body, "distribution-table") {
+		t.Errorf("expected no result table on error, got:\n%s", body)
+	}
+}
+
+func TestRegressionCalculateHandler_calc_error_shows_error(t *testing.T) {
+	form := url.Values{"points": {"1, 2\n2, 3"}}
+	req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	regressionCalculateHandler(w, req)
+	body := w.Body.String()
+	if !strings.Contains(body, `class="error"`) {
+		t.Errorf("expected error div, got:\n%s", body)
+	}
+	if strings.Contains(body, "distribution-table") {
+		t.Errorf("expected no result table on error, got:\n%s", body)
+	}
+}
+
+func TestRegressionCalculateHandler_exponential_model_shows_both_fits(t *testing.T) {
+	points := "1, 3.125\n2, 6.875\n3, 15.25\n4, 33.75\n5, 76.125\n6, 168"
+	form := url.Values{"points": {points}, "model": {"exponential"}, "refine": {"on"}}
+	req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w := httptest.NewRecorder()
+	regressionCalculateHandler(w, req)
+	body := w.Body.String()
+	for _, want := range []string{"Log-linear least squares", "Nonlinear least squares", "1.397690", "1.404155", "0.317094"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in body, got:\n%s", want, body)
+		}
+	}
+	if !strings.Contains(body, `value="exponential" checked`) || !strings.Contains(body, `value="on" checked`) {
+		t.Errorf("expected model and refinement pre-selected, got:\n%s", body)
+	}
+}
+
+func TestCalculateHandlers_concurrent_requests_share_the_caches(t *testing.T) {
+	interval := func(n int) string {
+		form := url.Values{"n": {strconv.Itoa(n)}, "k": {"7"}, "confidence": {"0.95"}}
+		req := httptest.NewRequest(http.MethodPost, "/interval/calculate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		intervalCalculateHandler(w, req)
+		return w.Body.String()
+	}
+	regression := func(last int) string {
+		points := fmt.Sprintf("1, 3.125\n2, 6.875\n3, 15.25\n4, 33.75\n5, 76.125\n6, %d", last)
+		form := url.Values{"points": {points}, "model": {"exponential"}, "refine": {"on"}}
+		req := httptest.NewRequest(http.MethodPost, "/regression/calculate", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		regressionCalculateHandler(w, req)
+		return w.Body.String()
+	}
+	// The first request builds the Padé coefficients, which takes minutes under -race when every goroutine waits on it.
+	// The parallel requests below use new arguments, so they still write new entries into the Ln caches
+	interval(20)
+	var wait sync.WaitGroup
+	for i := range 4 {
+		wait.Add(2)
+		go func() {
+			defer wait.Done()
+			if body := interval(21 + i); !strings.Contains(body, "Jeffreys") {
+				t.Errorf("expected every interval in body, got:\n%s", body)
+			}
+		}()
+		go func() {
+			defer wait.Done()
+			if body := regression(160 + i); !strings.Contains(body, "Nonlinear least squares") {
+				t.Errorf("expected the refined fit in body, got:\n%s", body)
+			}
+		}()
+	}
+	wait.Wait()
+}
@@ -0,0 +1,174 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// BinomialTestPower describes an exact binomial test at a fixed n. The test rejects when k <= LowerCritical or
+// k >= UpperCritical; a tail that can never reject has LowerCritical = -1 or UpperCritical = n + 1. Size is the
+// actual probability of rejecting under the null, which for a discrete test is usually below the nominal alpha
+type BinomialTestPower struct {
+	N             int64
+	LowerCritical int64
+	UpperCritical int64
+	Size          big.Float
+	Power         big.Float
+}
+
+// BinomialSampleSize reports where exact power first reaches the target. Exact power is a sawtooth in n, so reaching
+// the target once does not mean every larger n does: StableN is the smallest n from which every n up to the search
+// limit reaches the target, and Dips lists the n between the two that fall short
+type BinomialSampleSize struct {
+	N       int64
+	Power   big.Float
+	StableN int64
+	Dips    []int64
+}
+
+func validateBinomialPowerInputs(nullP, alternativeP, alpha *big.Float, tail string) error {
+	if err := ValidateTail(tail); err != nil {
+		return err
+	}
+	one := bu.StrToFloat("1")
+	if nullP.Sign() != 1 || nullP.Cmp(one) >= 0 {
+		return errors.New("binomial power null chance of success (p0) must be strictly between 0 and 1")
+	}
+	if alternativeP.Sign() == -1 || alternativeP.Cmp(one) > 0 {
+		return errors.New("binomial power alternative chance of success (p1) must be between 0 and 1")
+	}
+	if alpha.Sign() != 1 || alpha.Cmp(one) >= 0 {
+		return errors.New("binomial power significance level (alpha) must be strictly between 0 and 1")
+	}
+	return nil
+}
+
+// BinomialPower returns the exact power of BinomialPValue's test of p = nullP at level alpha when the true chance
+// of success is alternativeP. The two-sided test is the default doubling method, which rejects when either tail is at
+// most alpha/2
+func BinomialPower(nullP, alternativeP *big.Float, n int64, alpha *big.Float, tail string) (result BinomialTestPower, err error) {
+	if err := validateBinomialPowerInputs(nullP, alternativeP, alpha, tail); err != nil {
+		return BinomialTestPower{}, err
+	}
+	if n <= 0 {
+		return BinomialTestPower{}, errors.New("binomial power trials (n) must be positive")
+	}
+	return binomialPower(nullP, alternativeP, n, alpha, tail)
+}
+
+func binomialPower(nullP, alternativeP *big.Float, n int64, alpha *big.Float, tail string) (result BinomialTestPower, err error) {
+	tailAlpha := alpha
+	if tail == "two" {
+		tailAlpha = bu.PrecFloat().Quo(alpha, bu.StrToFloat("2"))
+	}
+	result = BinomialTestPower{N: n, LowerCritical: -1, UpperCritical: n + 1}
+	if tail != "right" {
+		result.LowerCritical, err = lowerCriticalValue(nullP, n, tailAlpha)
+		if err != nil {
+			return BinomialTestPower{}, err
+		}
+	}
+	if tail != "left" {
+		result.UpperCritical, err = upperCriticalValue(nullP, n, tailAlpha)
+		if err != nil {
+			return BinomialTestPower{}, err
+		}
+	}
+	size, err := rejectionProbability(nullP, n, result.LowerCritical, result.UpperCritical)
+	if err != nil {
+		return BinomialTestPower{}, err
+	}
+	power, err := rejectionProbability(alternativeP, n, result.LowerCritical, result.UpperCritical)
+	if err != nil {
+		return BinomialTestPower{}, err
+	}
+	result.Size, result.Power = *size, *power
+	return result, nil
+}
+
+// The largest k with P(X <= k) <= alpha, or -1 when even P(X = 0) is too likely
+func lowerCriticalValue(p *big.Float, n int64, alpha *big.Float) (int64, error) {
+	k, cumulative, err := BinomialQuantile(p, n, alpha)
+	if err != nil {
+		return 0, err
+	}
+	if cumulative.Cmp(alpha) > 0 {
+		k--
+	}
+	return k, nil
+}
+
+// The smallest k with P(X >= k) <= alpha, or n + 1 when even P(X = n) is too likely. P(X >= k) <= alpha is
+// P(X <= k - 1) >= 1 - alpha, so k - 1 is a quantile of the null distribution
+func upperCriticalValue(p *big.Float, n int64, alpha *big.Float) (int64, error) {
+	k, _, err := BinomialQuantile(p, n, bu.PrecFloat().Sub(bu.StrToFloat("1"), alpha))
+	if err != nil {
+		return 0, err
+	}
+	return k + 1, nil
+}
+
+// P(X <= lower) + P(X >= upper)
+func rejectionProbability(p *big.Float, n, lower, upper int64) (*big.Float, error) {
+	total := bu.PrecFloat().SetInt64(0)
+	if lower >= 0 {
+		left, _, err := binomialTails(p, n, lower)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, left)
+	}
+	if upper <= n {
+		_, right, err := binomialTails(p, n, upper)
+		if err != nil {
+			return nil, err
+		}
+		total.Add(total, right)
+	}
+	return total, nil
+}
+
+// BinomialSampleSizeForPower searches n = 1 through maxN for the first n whose exact power reaches targetPower, and
+// keeps going to maxN so the sawtooth past that point is reported rather than hidden
+func BinomialSampleSizeForPower(nullP, alternativeP, alpha, targetPower *big.Float, tail string, maxN int64) (result BinomialSampleSize, err error) {
+	if err := validateBinomialPowerInputs(nullP, alternativeP, alpha, tail); err != nil {
+		return BinomialSampleSize{}, err
+	}
+	if targetPower.Sign() != 1 || targetPower.Cmp(bu.StrToFloat("1")) >= 0 {
+		return BinomialSampleSize{}, errors.New("binomial sample size target power must be strictly between 0 and 1")
+	}
+	if maxN <= 0 {
+		return BinomialSampleSize{}, errors.New("binomial sample size search limit (maxN) must be positive")
+	}
+	result.N = -1
+	var shortfalls []int64
+	for n := int64(1); n <= maxN; n++ {
+		power, err := binomialPower(nullP, alternativeP, n, alpha, tail)
+		if err != nil {
+			return BinomialSampleSize{}, err
+		}
+		if power.Power.Cmp(targetPower) < 0 {
+			if result.N != -1 {
+				shortfalls = append(shortfalls, n)
+			}
+			continue
+		}
+		if result.N == -1 {
+			result.N, result.Power = n, power.Power
+		}
+	}
+	if result.N == -1 {
+		return BinomialSampleSize{}, errors.New("binomial sample size target power is not reached within the search limit (maxN)")
+	}
+	result.Dips = shortfalls
+	result.StableN = result.N
+	if len(shortfalls) > 0 {
+		result.StableN = shortfalls[len(shortfalls)-1] + 1
+	}
+	if result.StableN > maxN {
+		result.StableN = -1
+	}
+	return result, nil
+}
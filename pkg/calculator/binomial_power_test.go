@@ -0,0 +1,93 @@
+package calculator
+
+import (
+	"slices"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_BinomialPower(t *testing.T) {
+	tests := []struct {
+		name                 string
+		tail                 string
+		wantLower, wantUpper int64
+		wantSize, wantPower  string
+		wantErr              bool
+	}{
+		{name: "right-tailed test", tail: "right", wantLower: -1, wantUpper: 32, wantSize: "0.032454323536136", wantPower: "0.859440123611106"},
+		{name: "left-tailed test against a larger p has almost no power", tail: "left", wantLower: 18, wantUpper: 51, wantSize: "0.032454323536136", wantPower: "0.000000705879824"},
+		{name: "two-tailed test splits alpha", tail: "two", wantLower: 17, wantUpper: 33, wantSize: "0.032839137564268", wantPower: "0.782193222728048"},
+		{name: "invalid tail returns error", tail: "up", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BinomialPower(bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), 50, bu.StrToFloat("0.05"), tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BinomialPower() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.LowerCritical != tt.wantLower || got.UpperCritical != tt.wantUpper {
+				t.Errorf("critical values = (%d, %d), want (%d, %d)", got.LowerCritical, got.UpperCritical, tt.wantLower, tt.wantUpper)
+			}
+			if compare := bu.NewCompare(&got.Size, tt.wantSize); !compare.Equal() {
+				t.Errorf("Size = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(&got.Power, tt.wantPower); !compare.Equal() {
+				t.Errorf("Power = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_BinomialPowerRejectsInvalidInput(t *testing.T) {
+	if _, err := BinomialPower(bu.StrToFloat("1"), bu.StrToFloat("0.7"), 50, bu.StrToFloat("0.05"), "two"); err == nil {
+		t.Error("expected error for null p of 1")
+	}
+	if _, err := BinomialPower(bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), 0, bu.StrToFloat("0.05"), "two"); err == nil {
+		t.Error("expected error for n of 0")
+	}
+	if _, err := BinomialPower(bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), 50, bu.StrToFloat("0"), "two"); err == nil {
+		t.Error("expected error for alpha of 0")
+	}
+}
+
+func Test_BinomialSampleSizeForPower(t *testing.T) {
+	tests := []struct {
+		name        string
+		tail        string
+		wantN       int64
+		wantPower   string
+		wantStableN int64
+		wantDips    []int64
+	}{
+		{name: "two-tailed", tail: "two", wantN: 49, wantPower: "0.810002361162686", wantStableN: 54, wantDips: []int64{50, 53}},
+		{name: "right-tailed", tail: "right", wantN: 37, wantPower: "0.807095691652788", wantStableN: 42, wantDips: []int64{38, 39, 41}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BinomialSampleSizeForPower(bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.05"), bu.StrToFloat("0.8"), tt.tail, 100)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.N != tt.wantN || got.StableN != tt.wantStableN {
+				t.Errorf("N, StableN = %d, %d, want %d, %d", got.N, got.StableN, tt.wantN, tt.wantStableN)
+			}
+			if !slices.Equal(got.Dips, tt.wantDips) {
+				t.Errorf("Dips = %v, want %v", got.Dips, tt.wantDips)
+			}
+			if compare := bu.NewCompare(&got.Power, tt.wantPower); !compare.Equal() {
+				t.Errorf("Power = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_BinomialSampleSizeForPowerNotReached(t *testing.T) {
+	_, err := BinomialSampleSizeForPower(bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.05"), bu.StrToFloat("0.8"), "two", 20)
+	if err == nil {
+		t.Error("expected error when the target power is not reached within maxN")
+	}
+}
@@ -7,39 +7,269 @@ import (
 	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
 )
 
-func BinomialPValue(p *big.Float, n, k int64, tail string) (pValue big.Float, err error) {
-	if tail != "left" && tail != "right" && tail != "two" {
-		return big.Float{}, fmt.Errorf("tail must be \"left\", \"right\", or \"two\", got %q", tail)
+type TwoSidedMethod string
+
+const (
+	// 2 * min(P(X <= k), P(X >= k)), capped at 1
+	TwoSidedDoubling TwoSidedMethod = "doubling"
+	// The total probability of every outcome no more likely than the one observed, as in R's binom.test
+	TwoSidedMinLikelihood TwoSidedMethod = "minlike"
+	// The total probability of every outcome at least as far from the mean np as the one observed
+	TwoSidedCentral TwoSidedMethod = "central"
+)
+
+// BinomialPValueOptions picks how the "two" tail is computed and whether to apply the mid-p correction, which counts
+// only half of the probability of outcomes exactly as likely as the observed one. The zero value is doubling
+// without mid-p, BinomialPValue's original behaviour
+type BinomialPValueOptions struct {
+	TwoSided TwoSidedMethod
+	MidP     bool
+}
+
+// R's binom.test treats outcomes within this relative distance of the observed likelihood as equally likely
+const minLikelihoodRelativeError = "1.0000001"
+
+func BinomialPValue(p *big.Float, n, k int64, tail string, options ...BinomialPValueOptions) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	var option BinomialPValueOptions
+	if len(options) > 0 {
+		option = options[0]
 	}
-	left, _, err := CumulativeBinomialProbability(p, n, k)
+	if option.TwoSided == "" {
+		option.TwoSided = TwoSidedDoubling
+	}
+	if option.TwoSided != TwoSidedDoubling && option.TwoSided != TwoSidedMinLikelihood && option.TwoSided != TwoSidedCentral {
+		return big.Float{}, fmt.Errorf("two-sided method must be %q, %q, or %q, got %q", TwoSidedDoubling, TwoSidedMinLikelihood, TwoSidedCentral, option.TwoSided)
+	}
+	left, right, err := binomialTails(p, n, k)
 	if err != nil {
 		return big.Float{}, err
 	}
+	if tail == "two" && option.TwoSided == TwoSidedMinLikelihood {
+		return minLikelihoodPValue(p, n, k, left, right, option.MidP)
+	}
+	if tail == "two" && option.TwoSided == TwoSidedCentral {
+		return centralPValue(p, n, k, left, right, option.MidP)
+	}
+	if option.MidP {
+		halfTerm, err := binomialTerm(p, n, k)
+		if err != nil {
+			return big.Float{}, err
+		}
+		halfTerm.Quo(halfTerm, bu.StrToFloat("2"))
+		left = bu.PrecFloat().Sub(left, halfTerm)
+		right = bu.PrecFloat().Sub(right, halfTerm)
+	}
 	if tail == "left" {
-		return left, nil
+		return *left, nil
 	}
-	var right big.Float
-	if k == 0 {
-		right = *bu.StrToFloat("1")
+	if tail == "right" {
+		return *right, nil
+	}
+	return doubleSmallerTail(left, right), nil
+}
+
+// centralPValue adds the tail beyond k to the tail beyond its mirror image 2np - k on the other side of the mean
+func centralPValue(p *big.Float, n, k int64, left, right *big.Float, midP bool) (pValue big.Float, err error) {
+	mean := bu.PrecFloat().Mul(p, bu.PrecFloat().SetInt64(n))
+	mirror := bu.PrecFloat().Sub(bu.PrecFloat().Mul(mean, bu.StrToFloat("2")), bu.PrecFloat().SetInt64(k))
+	// p is rarely exact in binary, so a mirror within rounding of a whole number is taken to be that number
+	rounded, _ := bu.PrecFloat().Add(mirror, bu.StrToFloat("0.5")).Int64()
+	if mirror.Sign() == -1 {
+		rounded, _ = bu.PrecFloat().Sub(mirror, bu.StrToFloat("0.5")).Int64()
+	}
+	isWhole := isConverged(bu.PrecFloat().Sub(mirror, bu.PrecFloat().SetInt64(rounded)), bu.PrecFloat().Add(mean, bu.StrToFloat("1")))
+
+	var sameSide, otherSide *big.Float
+	var boundary int64
+	if isWhole && rounded == k {
+		// k sits on the mean, so every outcome is at least as far from it
+		sameSide, otherSide, boundary = bu.StrToFloat("1"), bu.PrecFloat().SetInt64(0), -1
+	} else if bu.PrecFloat().SetInt64(k).Cmp(mean) < 0 {
+		// Everything at or above the mirror
+		boundary = rounded
+		if !isWhole && mirror.Cmp(bu.PrecFloat().SetInt64(rounded)) > 0 {
+			boundary++
+		}
+		sameSide, otherSide = left, bu.PrecFloat().SetInt64(0)
+		if boundary <= n {
+			_, otherSide, err = binomialTails(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+		}
 	} else {
-		rightCum, _, err := CumulativeBinomialProbability(p, n, k-1)
+		// Everything at or below the mirror
+		boundary = rounded
+		if !isWhole && mirror.Cmp(bu.PrecFloat().SetInt64(rounded)) < 0 {
+			boundary--
+		}
+		sameSide, otherSide = right, bu.PrecFloat().SetInt64(0)
+		if boundary >= 0 {
+			otherSide, _, err = binomialTails(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+		}
+	}
+	total := bu.PrecFloat().Add(sameSide, otherSide)
+	if midP {
+		// Half of the observed outcome, and half of its mirror image when that is an outcome too
+		observed, err := binomialTerm(p, n, k)
 		if err != nil {
 			return big.Float{}, err
 		}
-		right = *bu.PrecFloat().Sub(bu.StrToFloat("1"), &rightCum)
+		total.Sub(total, bu.PrecFloat().Quo(observed, bu.StrToFloat("2")))
+		if isWhole && boundary >= 0 && boundary <= n {
+			mirrorTerm, err := binomialTerm(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+			total.Sub(total, bu.PrecFloat().Quo(mirrorTerm, bu.StrToFloat("2")))
+		}
 	}
-	if tail == "right" {
-		return right, nil
+	if total.Cmp(bu.StrToFloat("1")) > 0 {
+		return *bu.StrToFloat("1"), nil
 	}
-	var minVal big.Float
-	if left.Cmp(&right) <= 0 {
-		minVal = left
+	return *total, nil
+}
+
+// minLikelihoodPValue follows binom.test: the outcomes on the observed side of the mean np are all no more likely
+// than k, so only the far side needs searching. The terms there fall away from the mode, so the cut-off is found
+// by bisection rather than by evaluating every term
+func minLikelihoodPValue(p *big.Float, n, k int64, left, right *big.Float, midP bool) (pValue big.Float, err error) {
+	mean := bu.PrecFloat().Mul(p, bu.PrecFloat().SetInt64(n))
+	kFloat := bu.PrecFloat().SetInt64(k)
+	if kFloat.Cmp(mean) == 0 {
+		return *bu.StrToFloat("1"), nil
+	}
+	observed, err := binomialTerm(p, n, k)
+	if err != nil {
+		return big.Float{}, err
+	}
+	threshold := bu.PrecFloat().Mul(observed, bu.StrToFloat(minLikelihoodRelativeError))
+	noMoreLikely := func(i int64) (bool, error) {
+		term, err := binomialTerm(p, n, i)
+		if err != nil {
+			return false, err
+		}
+		return term.Cmp(threshold) <= 0, nil
+	}
+
+	var sameSide, otherSide *big.Float
+	var boundary int64
+	if kFloat.Cmp(mean) < 0 {
+		// Smallest j above the mean with P(X = j) <= P(X = k), then everything from j to n
+		meanCeil, accuracy := mean.Int64()
+		if accuracy == big.Below {
+			meanCeil++
+		}
+		lo, hi := meanCeil-1, n+1
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			isBelow, err := noMoreLikely(mid)
+			if err != nil {
+				return big.Float{}, err
+			}
+			if isBelow {
+				hi = mid
+			} else {
+				lo = mid
+			}
+		}
+		boundary = hi
+		sameSide, otherSide = left, bu.PrecFloat().SetInt64(0)
+		if boundary <= n {
+			_, otherSide, err = binomialTails(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+		}
 	} else {
+		// Largest i below the mean with P(X = i) <= P(X = k), then everything from 0 to i
+		meanFloor, _ := mean.Int64()
+		lo, hi := int64(-1), min(meanFloor+1, k)
+		for hi-lo > 1 {
+			mid := lo + (hi-lo)/2
+			isBelow, err := noMoreLikely(mid)
+			if err != nil {
+				return big.Float{}, err
+			}
+			if isBelow {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+		boundary = lo
+		sameSide, otherSide = right, bu.PrecFloat().SetInt64(0)
+		if boundary >= 0 {
+			otherSide, _, err = binomialTails(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+		}
+	}
+	total := bu.PrecFloat().Add(sameSide, otherSide)
+	if midP {
+		// Half of the observed outcome, and half of the boundary outcome if it ties with it
+		total.Sub(total, bu.PrecFloat().Quo(observed, bu.StrToFloat("2")))
+		if boundary >= 0 && boundary <= n {
+			boundaryTerm, err := binomialTerm(p, n, boundary)
+			if err != nil {
+				return big.Float{}, err
+			}
+			tieFloor := bu.PrecFloat().Quo(observed, bu.StrToFloat(minLikelihoodRelativeError))
+			if boundaryTerm.Cmp(tieFloor) >= 0 {
+				total.Sub(total, bu.PrecFloat().Quo(boundaryTerm, bu.StrToFloat("2")))
+			}
+		}
+	}
+	if total.Cmp(bu.StrToFloat("1")) > 0 {
+		return *bu.StrToFloat("1"), nil
+	}
+	return *total, nil
+}
+
+// binomialTails returns P(X <= k) and P(X >= k), choosing between exact summation and the incomplete beta function
+// the same way BinomialCDF does
+func binomialTails(p *big.Float, n, k int64) (left *big.Float, right *big.Float, err error) {
+	if n > binomialSummationLimit {
+		return binomialTailsIncompleteBeta(p, n, k)
+	}
+	leftCum, _, err := CumulativeBinomialProbability(p, n, k)
+	if err != nil {
+		return nil, nil, err
+	}
+	if k == 0 {
+		return &leftCum, bu.StrToFloat("1"), nil
+	}
+	rightCum, _, err := CumulativeBinomialProbability(p, n, k-1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &leftCum, bu.PrecFloat().Sub(bu.StrToFloat("1"), &rightCum), nil
+}
+
+// ValidateTail checks that tail is "left", "right" or "two", the names every p-value in this module accepts
+func ValidateTail(tail string) error {
+	if tail != "left" && tail != "right" && tail != "two" {
+		return fmt.Errorf("tail must be \"left\", \"right\", or \"two\", got %q", tail)
+	}
+	return nil
+}
+
+// Two-tailed p-value by doubling the smaller of the two one-tailed p-values, capped at 1
+func doubleSmallerTail(left, right *big.Float) big.Float {
+	minVal := left
+	if right.Cmp(left) < 0 {
 		minVal = right
 	}
-	two := *bu.PrecFloat().Mul(bu.StrToFloat("2"), &minVal)
+	two := *bu.PrecFloat().Mul(bu.StrToFloat("2"), minVal)
 	if two.Cmp(bu.StrToFloat("1")) > 0 {
-		return *bu.StrToFloat("1"), nil
+		return *bu.StrToFloat("1")
 	}
-	return two, nil
+	return two
 }
@@ -48,6 +48,11 @@ func Test_BinomialPValue(t *testing.T) {
 			p:    half, n: 3, k: 1, tail: "center",
 			wantErr: true,
 		},
+		{
+			name: "right tail with large n",
+			p:    half, n: 500000, k: 250301, tail: "right",
+			want: "0.197678580001903066464722904469",
+		},
 		{
 			name: "p > 1 returns error",
 			p:    bu.StrToFloat("1.1"), n: 3, k: 1, tail: "left",
@@ -69,3 +74,90 @@ func Test_BinomialPValue(t *testing.T) {
 		})
 	}
 }
+
+func Test_BinomialPValueTwoSidedMethods(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *big.Float
+		n, k    int64
+		options BinomialPValueOptions
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "zero value options keep doubling",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 10,
+			want: "0.095923794662687",
+		},
+		{
+			name: "minimum likelihood matches binom.test",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 10, options: BinomialPValueOptions{TwoSided: TwoSidedMinLikelihood},
+			want: "0.083445029629812",
+		},
+		{
+			name: "minimum likelihood below the mean",
+			p:    bu.StrToFloat("0.1"), n: 30, k: 0, options: BinomialPValueOptions{TwoSided: TwoSidedMinLikelihood},
+			want: "0.068217946937126",
+		},
+		{
+			name: "minimum likelihood with mid-p",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 2, options: BinomialPValueOptions{TwoSided: TwoSidedMinLikelihood, MidP: true},
+			want: "0.038705012467593",
+		},
+		{
+			name: "minimum likelihood on a large n",
+			p:    bu.StrToFloat("0.3"), n: 50000, k: 14800, options: BinomialPValueOptions{TwoSided: TwoSidedMinLikelihood},
+			want: "0.0509625663",
+		},
+		{
+			name: "central counts outcomes at least as far from the mean",
+			p:    bu.StrToFloat("0.1"), n: 30, k: 0, options: BinomialPValueOptions{TwoSided: TwoSidedCentral},
+			want: "0.115581266675096",
+		},
+		{
+			name: "central with mid-p halves the observed outcome and its mirror image",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 10, options: BinomialPValueOptions{TwoSided: TwoSidedCentral, MidP: true},
+			want: "0.054113552917635",
+		},
+		{
+			name: "central at the mean is 1",
+			p:    bu.StrToFloat("0.3"), n: 10, k: 3, options: BinomialPValueOptions{TwoSided: TwoSidedCentral},
+			want: "1.0",
+		},
+		{
+			name: "doubling with mid-p",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 10, options: BinomialPValueOptions{MidP: true},
+			want: "0.065106713762602",
+		},
+		{
+			name: "unknown method returns error",
+			p:    bu.StrToFloat("0.3"), n: 20, k: 10, options: BinomialPValueOptions{TwoSided: "blaker"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pval, err := BinomialPValue(tt.p, tt.n, tt.k, "two", tt.options)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BinomialPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&pval, tt.want); !compare.Equal() {
+				t.Errorf("BinomialPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_BinomialPValueMidPOneSided(t *testing.T) {
+	// P(X <= 1) - P(X = 1)/2 = 0.5 - 0.375/2
+	pval, err := BinomialPValue(bu.StrToFloat("0.5"), 3, 1, "left", BinomialPValueOptions{MidP: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&pval, "0.3125"); !compare.Equal() {
+		t.Errorf("BinomialPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
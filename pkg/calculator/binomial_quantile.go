@@ -0,0 +1,105 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// BinomialQuantile returns the smallest k with P(X <= k) >= alpha, along with P(X <= k) itself
+func BinomialQuantile(p *big.Float, n int64, alpha *big.Float) (k int64, cumulative big.Float, err error) {
+	one := bu.StrToFloat("1")
+	if p.Sign() == -1 || p.Cmp(one) > 0 {
+		return 0, big.Float{}, errors.New("binomial quantile chance of success (p) must be between 0 and 1")
+	}
+	if n < 0 {
+		return 0, big.Float{}, errors.New("binomial quantile trials (n) cannot be negative")
+	}
+	if alpha.Sign() == -1 || alpha.Cmp(one) > 0 {
+		return 0, big.Float{}, errors.New("binomial quantile target probability (alpha) must be between 0 and 1")
+	}
+	// With no trials, or no chance of success, X is always 0 and P(X <= 0) = 1
+	if n == 0 || p.Sign() == 0 {
+		return 0, *bu.PrecFloat().SetInt64(1), nil
+	}
+	if p.Cmp(one) == 0 {
+		if alpha.Sign() == 0 {
+			return 0, *bu.PrecFloat().SetInt64(0), nil
+		}
+		return n, *bu.PrecFloat().SetInt64(1), nil
+	}
+
+	// Start from the normal approximation, then walk one term at a time. Each step only adds or removes P(X = k),
+	// which follows from its neighbour by the ratio (n - k)/(k + 1) * p/(1 - p)
+	k = binomialQuantileGuess(p, n, alpha)
+	current, err := BinomialCDF(p, n, k)
+	if err != nil {
+		return 0, big.Float{}, err
+	}
+	term, err := binomialTerm(p, n, k)
+	if err != nil {
+		return 0, big.Float{}, err
+	}
+	odds := bu.PrecFloat().Quo(p, bu.PrecFloat().Sub(one, p))
+	for current.Cmp(alpha) < 0 && k < n {
+		term.Mul(term, bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(n-k), bu.PrecFloat().SetInt64(k+1)))
+		term.Mul(term, odds)
+		k++
+		current.Add(&current, term)
+	}
+	for k > 0 {
+		below := bu.PrecFloat().Sub(&current, term)
+		if below.Cmp(alpha) < 0 {
+			break
+		}
+		current = *below
+		term.Mul(term, bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(k), bu.PrecFloat().SetInt64(n-k+1)))
+		term.Quo(term, odds)
+		k--
+	}
+	return k, current, nil
+}
+
+// k ~ np + z * sqrt(np(1 - p)), clamped to the support
+func binomialQuantileGuess(p *big.Float, n int64, alpha *big.Float) int64 {
+	pFloat, _ := p.Float64()
+	alphaFloat, _ := alpha.Float64()
+	mean := float64(n) * pFloat
+	z := -math.Sqrt2 * math.Erfcinv(2*alphaFloat)
+	guess := math.Floor(mean + z*math.Sqrt(mean*(1-pFloat)))
+	if math.IsNaN(guess) || guess < 0 {
+		return 0
+	}
+	if guess > float64(n) {
+		return n
+	}
+	return int64(guess)
+}
+
+// binomialTerm returns P(X = k), going through LogBinomialCoefficient once the factorials would get large
+func binomialTerm(p *big.Float, n, k int64) (*big.Float, error) {
+	if n <= binomialSummationLimit || p.Sign() == 0 || p.Cmp(bu.StrToFloat("1")) == 0 {
+		probability, err := CalculateBinomialProbability(p, n, k)
+		if err != nil {
+			return nil, err
+		}
+		return &probability, nil
+	}
+	logCoefficient, err := LogBinomialCoefficient(bu.PrecFloat().SetInt64(n), bu.PrecFloat().SetInt64(k))
+	if err != nil {
+		return nil, err
+	}
+	lnP, err := Ln(p)
+	if err != nil {
+		return nil, err
+	}
+	lnQ, err := Ln(bu.PrecFloat().Sub(bu.StrToFloat("1"), p))
+	if err != nil {
+		return nil, err
+	}
+	logTerm := bu.PrecFloat().Add(logCoefficient, bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(k), lnP))
+	logTerm.Add(logTerm, bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(n-k), lnQ))
+	return Exp(logTerm), nil
+}
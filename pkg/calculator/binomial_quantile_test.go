@@ -0,0 +1,88 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_BinomialQuantile(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         *big.Float
+		n         int64
+		alpha     *big.Float
+		wantK     int64
+		wantCumul string
+		wantErr   bool
+	}{
+		{
+			name: "It should stop where the CDF meets alpha exactly",
+			p:    bu.StrToFloat("0.5"), n: 3, alpha: bu.StrToFloat("0.5"),
+			wantK: 1, wantCumul: "0.5",
+		},
+		{
+			name: "It should step past a CDF just below alpha",
+			p:    bu.StrToFloat("0.5"), n: 3, alpha: bu.StrToFloat("0.5000001"),
+			wantK: 2, wantCumul: "0.875",
+		},
+		{
+			name: "It should find an upper acceptance threshold",
+			p:    bu.StrToFloat("0.3"), n: 20, alpha: bu.StrToFloat("0.95"),
+			wantK: 9, wantCumul: "0.952038102668657",
+		},
+		{
+			name: "It should find a lower threshold for a skewed p",
+			p:    bu.StrToFloat("0.9"), n: 30, alpha: bu.StrToFloat("0.05"),
+			wantK: 24, wantCumul: "0.073190108399880",
+		},
+		{
+			name: "It should return 0 for alpha of 0",
+			p:    bu.StrToFloat("0.3"), n: 20, alpha: bu.StrToFloat("0"),
+			wantK: 0, wantCumul: "0.000797922662976",
+		},
+		{
+			name: "It should return n for alpha of 1",
+			p:    bu.StrToFloat("0.3"), n: 20, alpha: bu.StrToFloat("1"),
+			wantK: 20, wantCumul: "1.0",
+		},
+		{
+			name: "It should report a cumulative of 1 for no trials",
+			p:    bu.StrToFloat("1"), n: 0, alpha: bu.StrToFloat("0"),
+			wantK: 0, wantCumul: "1",
+		},
+		{
+			name: "It should handle large n",
+			p:    bu.StrToFloat("0.001"), n: 2000000, alpha: bu.StrToFloat("0.0125"),
+			wantK: 1900, wantCumul: "0.012510452165449",
+		},
+		{
+			name: "It should reject alpha above 1",
+			p:    bu.StrToFloat("0.3"), n: 20, alpha: bu.StrToFloat("1.5"),
+			wantErr: true,
+		},
+		{
+			name: "It should reject p below 0",
+			p:    bu.StrToFloat("-0.3"), n: 20, alpha: bu.StrToFloat("0.5"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, cumulative, err := BinomialQuantile(tt.p, tt.n, tt.alpha)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BinomialQuantile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if k != tt.wantK {
+				t.Errorf("BinomialQuantile() k = %d, want %d", k, tt.wantK)
+			}
+			if compare := bu.NewCompare(&cumulative, tt.wantCumul); !compare.Equal() {
+				t.Errorf("BinomialQuantile() cumulative = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package calculator
+
+import "sync"
+
+// stringCache holds results as strings, so a cached value can be handed out again without sharing a *big.Float that
+// the caller might mutate. The calculators are called from concurrent HTTP requests, so every access takes the lock
+type stringCache[K comparable] struct {
+	mu    sync.RWMutex
+	data  map[K]string
+	limit int
+}
+
+func (c *stringCache[K]) get(key K) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	v, ok := c.data[key]
+	return v, ok
+}
+
+// set stores the value, first emptying the cache if it has grown past a non-zero limit
+func (c *stringCache[K]) set(key K, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.limit > 0 && len(c.data) > c.limit {
+		clear(c.data)
+	}
+	c.data[key] = value
+}
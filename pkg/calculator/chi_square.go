@@ -0,0 +1,106 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func ChiSquarePDF(x, degreesOfFreedom *big.Float) (density big.Float, err error) {
+	if degreesOfFreedom.Sign() != 1 {
+		return big.Float{}, errors.New("chi-square degrees of freedom (k) must be positive")
+	}
+	if x.Sign() != 1 {
+		return *bu.PrecFloat().SetInt64(0), nil
+	}
+	// f(x) = x^(k/2 - 1) * e^(-x/2) / (2^(k/2) * Γ(k/2))
+	halfK := bu.PrecFloat().Quo(degreesOfFreedom, bu.StrToFloat("2"))
+	lnGammaHalfK, err := LogGamma(halfK)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnX, err := Ln(x)
+	if err != nil {
+		return big.Float{}, err
+	}
+	ln2, err := Ln(bu.StrToFloat("2"))
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnDensity := bu.PrecFloat().Mul(bu.PrecFloat().Sub(halfK, bu.StrToFloat("1")), lnX)
+	lnDensity.Sub(lnDensity, bu.PrecFloat().Quo(x, bu.StrToFloat("2")))
+	lnDensity.Sub(lnDensity, bu.PrecFloat().Mul(halfK, ln2))
+	lnDensity.Sub(lnDensity, lnGammaHalfK)
+	return *Exp(lnDensity), nil
+}
+
+// P(X <= x) = P(k/2, x/2)
+func ChiSquareCDF(x, degreesOfFreedom *big.Float) (cumulative big.Float, err error) {
+	if degreesOfFreedom.Sign() != 1 {
+		return big.Float{}, errors.New("chi-square degrees of freedom (k) must be positive")
+	}
+	if x.Sign() != 1 {
+		return *bu.PrecFloat().SetInt64(0), nil
+	}
+	half := bu.StrToFloat("0.5")
+	lower, err := RegularizedLowerGamma(bu.PrecFloat().Mul(degreesOfFreedom, half), bu.PrecFloat().Mul(x, half))
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *lower, nil
+}
+
+func ChiSquarePValue(x, degreesOfFreedom *big.Float, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	if degreesOfFreedom.Sign() != 1 {
+		return big.Float{}, errors.New("chi-square degrees of freedom (k) must be positive")
+	}
+	var left, right *big.Float
+	if x.Sign() != 1 {
+		left, right = bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(1)
+	} else {
+		half := bu.StrToFloat("0.5")
+		left, right, err = regularizedGamma(bu.PrecFloat().Mul(degreesOfFreedom, half), bu.PrecFloat().Mul(x, half))
+		if err != nil {
+			return big.Float{}, err
+		}
+		one := bu.StrToFloat("1")
+		if left == nil {
+			left = bu.PrecFloat().Sub(one, right)
+		} else {
+			right = bu.PrecFloat().Sub(one, left)
+		}
+	}
+	switch tail {
+	case "left":
+		return *left, nil
+	case "right":
+		return *right, nil
+	}
+	return doubleSmallerTail(left, right), nil
+}
+
+func ChiSquareQuantile(probability, degreesOfFreedom *big.Float) (quantile big.Float, err error) {
+	if degreesOfFreedom.Sign() != 1 {
+		return big.Float{}, errors.New("chi-square degrees of freedom (k) must be positive")
+	}
+	// Start from the Wilson-Hilferty approximation, x ~ k(1 - 2/(9k) + z * sqrt(2/(9k)))^3
+	p, _ := probability.Float64()
+	k, _ := degreesOfFreedom.Float64()
+	z := -math.Sqrt2 * math.Erfcinv(2*p)
+	if math.IsInf(z, 0) || math.IsNaN(z) {
+		z = 0
+	}
+	cube := 1 - 2/(9*k) + z*math.Sqrt(2/(9*k))
+	guess := k * cube * cube * cube
+	if guess <= 0 || math.IsNaN(guess) {
+		guess = k
+	}
+	cdf := func(x *big.Float) (big.Float, error) { return ChiSquareCDF(x, degreesOfFreedom) }
+	pdf := func(x *big.Float) (big.Float, error) { return ChiSquarePDF(x, degreesOfFreedom) }
+	return invertCDF(probability, bu.PrecFloat().SetFloat64(guess), bu.PrecFloat().SetInt64(0), nil, cdf, pdf)
+}
@@ -0,0 +1,95 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_ChiSquarePDF(t *testing.T) {
+	got, err := ChiSquarePDF(bu.StrToFloat("2"), bu.StrToFloat("3"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&got, "0.2075537487102974"); !compare.Equal() {
+		t.Errorf("ChiSquarePDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, err := ChiSquarePDF(bu.StrToFloat("2"), bu.StrToFloat("-3")); err == nil {
+		t.Error("expected error for negative degrees of freedom")
+	}
+}
+
+func Test_ChiSquareCDF(t *testing.T) {
+	tests := []struct {
+		name                string
+		x, degreesOfFreedom *big.Float
+		want                string
+	}{
+		{name: "one degree of freedom is erf(sqrt(x/2))", x: bu.StrToFloat("3.84"), degreesOfFreedom: bu.StrToFloat("1"), want: "0.949956478751295"},
+		{name: "non-positive x", x: bu.StrToFloat("-1"), degreesOfFreedom: bu.StrToFloat("1"), want: "0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChiSquareCDF(tt.x, tt.degreesOfFreedom)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("ChiSquareCDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_ChiSquarePValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		// With 4 degrees of freedom the right tail at 10 is 6e^-5
+		{name: "right tail", tail: "right", want: "0.0404276819945128"},
+		{name: "left tail", tail: "left", want: "0.9595723180054872"},
+		{name: "two tails", tail: "two", want: "0.0808553639890256"},
+		{name: "invalid tail returns error", tail: "up", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChiSquarePValue(bu.StrToFloat("10"), bu.StrToFloat("4"), tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ChiSquarePValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("ChiSquarePValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_ChiSquareQuantile(t *testing.T) {
+	tests := []struct {
+		name                          string
+		probability, degreesOfFreedom *big.Float
+		want                          string
+	}{
+		{name: "95% critical value for 1 df", probability: bu.StrToFloat("0.95"), degreesOfFreedom: bu.StrToFloat("1"), want: "3.841458820694126"},
+		{name: "95% critical value for 3 df", probability: bu.StrToFloat("0.95"), degreesOfFreedom: bu.StrToFloat("3"), want: "7.814727903251180"},
+		{name: "lower tail", probability: bu.StrToFloat("0.01"), degreesOfFreedom: bu.StrToFloat("10"), want: "2.558212160187206"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ChiSquareQuantile(tt.probability, tt.degreesOfFreedom)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("ChiSquareQuantile() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
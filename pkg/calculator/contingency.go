@@ -0,0 +1,234 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+type ChiSquareTestMethod string
+
+const (
+	// Pearson's X^2 = Σ (O - E)^2 / E
+	PearsonChiSquare ChiSquareTestMethod = "pearson"
+	// The G-test, G = 2 Σ O ln(O / E)
+	LikelihoodRatio ChiSquareTestMethod = "likelihood-ratio"
+)
+
+// ChiSquareTestOptions picks the statistic, Pearson by default. YatesCorrection moves every observed count half a
+// unit towards its expected count before the statistic is taken, and is only accepted for a 2×2 table
+type ChiSquareTestOptions struct {
+	Method          ChiSquareTestMethod
+	YatesCorrection bool
+}
+
+type ChiSquareTestResult struct {
+	Statistic        big.Float
+	DegreesOfFreedom int64
+	Expected         *matrix.BigMatrix[*big.Float]
+	PValue           big.Float
+}
+
+// GoodnessOfFitTest compares a single row or column of counts with the given category probabilities, or with equal
+// probabilities when none are given. The expected-count matrix has the same shape as the observed one
+func GoodnessOfFitTest[T op.Number | op.BigNumber, U op.FloatNumber](observed *matrix.Matrix[T, U], probabilities []*big.Float, options ...ChiSquareTestOptions) (result ChiSquareTestResult, err error) {
+	counts, err := tableCounts(observed)
+	if err != nil {
+		return ChiSquareTestResult{}, err
+	}
+	if len(counts) != 1 && len(counts[0]) != 1 {
+		return ChiSquareTestResult{}, errors.New("goodness-of-fit counts must be a single row or column")
+	}
+	categories := len(counts) * len(counts[0])
+	if categories < 2 {
+		return ChiSquareTestResult{}, errors.New("goodness-of-fit counts must have at least two categories")
+	}
+	if probabilities == nil {
+		probabilities = make([]*big.Float, categories)
+		for i := range probabilities {
+			probabilities[i] = bu.PrecFloat().Quo(bu.StrToFloat("1"), bu.PrecFloat().SetInt64(int64(categories)))
+		}
+	}
+	if len(probabilities) != categories {
+		return ChiSquareTestResult{}, errors.New("goodness-of-fit counts and probabilities must have the same length")
+	}
+	one := bu.StrToFloat("1")
+	for _, p := range probabilities {
+		if p == nil || p.Sign() != 1 || p.Cmp(one) > 0 {
+			return ChiSquareTestResult{}, errors.New("goodness-of-fit probabilities must be greater than 0 and at most 1")
+		}
+	}
+	if !sumsToOne(probabilities) {
+		return ChiSquareTestResult{}, errors.New("goodness-of-fit probabilities must sum to 1")
+	}
+
+	total := bu.PrecFloat().SetInt64(0)
+	for _, row := range counts {
+		for _, count := range row {
+			total.Add(total, count)
+		}
+	}
+	if total.Sign() == 0 {
+		return ChiSquareTestResult{}, errors.New("goodness-of-fit counts must include at least one observation")
+	}
+	expected := make([][]*big.Float, len(counts))
+	category := 0
+	for i, row := range counts {
+		expected[i] = make([]*big.Float, len(row))
+		for j := range row {
+			expected[i][j] = bu.PrecFloat().Mul(total, probabilities[category])
+			category++
+		}
+	}
+	return chiSquareTest(counts, expected, int64(categories-1), options)
+}
+
+// IndependenceTest tests the rows and columns of an r×c table of counts for independence, with expected counts
+// E_ij = (row total_i)(column total_j) / n and (r - 1)(c - 1) degrees of freedom
+func IndependenceTest[T op.Number | op.BigNumber, U op.FloatNumber](table *matrix.Matrix[T, U], options ...ChiSquareTestOptions) (result ChiSquareTestResult, err error) {
+	counts, err := tableCounts(table)
+	if err != nil {
+		return ChiSquareTestResult{}, err
+	}
+	if len(counts) < 2 || len(counts[0]) < 2 {
+		return ChiSquareTestResult{}, errors.New("independence test table must have at least two rows and two columns")
+	}
+	rowTotals := make([]*big.Float, len(counts))
+	columnTotals := make([]*big.Float, len(counts[0]))
+	for j := range columnTotals {
+		columnTotals[j] = bu.PrecFloat().SetInt64(0)
+	}
+	total := bu.PrecFloat().SetInt64(0)
+	for i, row := range counts {
+		rowTotals[i] = bu.PrecFloat().SetInt64(0)
+		for j, count := range row {
+			rowTotals[i].Add(rowTotals[i], count)
+			columnTotals[j].Add(columnTotals[j], count)
+		}
+		total.Add(total, rowTotals[i])
+	}
+	for _, rowTotal := range rowTotals {
+		if rowTotal.Sign() == 0 {
+			return ChiSquareTestResult{}, errors.New("independence test table cannot have a row of zeros")
+		}
+	}
+	for _, columnTotal := range columnTotals {
+		if columnTotal.Sign() == 0 {
+			return ChiSquareTestResult{}, errors.New("independence test table cannot have a column of zeros")
+		}
+	}
+	expected := make([][]*big.Float, len(counts))
+	for i := range counts {
+		expected[i] = make([]*big.Float, len(counts[i]))
+		for j := range counts[i] {
+			expected[i][j] = bu.PrecFloat().Quo(bu.PrecFloat().Mul(rowTotals[i], columnTotals[j]), total)
+		}
+	}
+	return chiSquareTest(counts, expected, int64((len(counts)-1)*(len(counts[0])-1)), options)
+}
+
+// tableCounts copies a matrix of counts into working-precision floats, rejecting anything that is not a count
+func tableCounts[T op.Number | op.BigNumber, U op.FloatNumber](table *matrix.Matrix[T, U]) ([][]*big.Float, error) {
+	if table == nil || table.Rows() == 0 || table.Columns() == 0 {
+		return nil, errors.New("table of counts cannot be empty")
+	}
+	rows := table.GetRowOperands()
+	counts := make([][]*big.Float, len(rows))
+	for i, row := range rows {
+		counts[i] = make([]*big.Float, len(row))
+		for j, operand := range row {
+			count := bu.PrecFloat()
+			switch value := any(operand.Value).(type) {
+			case int:
+				count.SetInt64(int64(value))
+			case float64:
+				count.SetFloat64(value)
+			case *big.Int:
+				count.SetInt(value)
+			case *big.Float:
+				count.Set(value)
+			}
+			if count.Sign() == -1 || !count.IsInt() {
+				return nil, errors.New("table of counts must contain non-negative integers")
+			}
+			counts[i][j] = count
+		}
+	}
+	return counts, nil
+}
+
+func chiSquareTest(counts, expected [][]*big.Float, degreesOfFreedom int64, options []ChiSquareTestOptions) (result ChiSquareTestResult, err error) {
+	var option ChiSquareTestOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	method := option.Method
+	if method == "" {
+		method = PearsonChiSquare
+	}
+	if method != PearsonChiSquare && method != LikelihoodRatio {
+		return ChiSquareTestResult{}, errors.New("chi-square test method must be \"pearson\" or \"likelihood-ratio\"")
+	}
+	if option.YatesCorrection && (len(counts) != 2 || len(counts[0]) != 2) {
+		return ChiSquareTestResult{}, errors.New("yates' correction only applies to a 2×2 table")
+	}
+
+	half := bu.StrToFloat("0.5")
+	statistic := bu.PrecFloat().SetInt64(0)
+	for i, row := range counts {
+		for j, count := range row {
+			observed := bu.PrecFloat().Copy(count)
+			difference := bu.PrecFloat().Sub(observed, expected[i][j])
+			if option.YatesCorrection {
+				// |O - E| is reduced by 1/2, but never past E itself
+				shift := bu.PrecFloat().Abs(difference)
+				if shift.Cmp(half) > 0 {
+					shift.Set(half)
+				}
+				if difference.Sign() == -1 {
+					shift.Neg(shift)
+				}
+				observed.Sub(observed, shift)
+				difference.Sub(difference, shift)
+			}
+			if method == PearsonChiSquare {
+				statistic.Add(statistic, bu.PrecFloat().Quo(bu.PrecFloat().Mul(difference, difference), expected[i][j]))
+				continue
+			}
+			// O ln(O / E) -> 0 as O -> 0
+			if observed.Sign() == 0 {
+				continue
+			}
+			lnRatio, err := Ln(bu.PrecFloat().Quo(observed, expected[i][j]))
+			if err != nil {
+				return ChiSquareTestResult{}, err
+			}
+			statistic.Add(statistic, bu.PrecFloat().Mul(observed, lnRatio))
+		}
+	}
+	if method == LikelihoodRatio {
+		statistic.Mul(statistic, bu.StrToFloat("2"))
+		// Rounding can leave a perfect fit a hair below zero
+		if statistic.Sign() == -1 {
+			statistic.SetInt64(0)
+		}
+	}
+
+	pValue, err := ChiSquarePValue(statistic, bu.PrecFloat().SetInt64(degreesOfFreedom), "right")
+	if err != nil {
+		return ChiSquareTestResult{}, err
+	}
+	expectedMatrix, err := matrix.NewBigMatrix(expected)
+	if err != nil {
+		return ChiSquareTestResult{}, err
+	}
+	return ChiSquareTestResult{
+		Statistic:        *statistic,
+		DegreesOfFreedom: degreesOfFreedom,
+		Expected:         expectedMatrix,
+		PValue:           pValue,
+	}, nil
+}
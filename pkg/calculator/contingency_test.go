@@ -0,0 +1,157 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+)
+
+func Test_IndependenceTest(t *testing.T) {
+	twoByThree, _ := matrix.NewNumberMatrix([][]int{{10, 20, 30}, {20, 15, 25}})
+	twoByTwo, _ := matrix.NewNumberMatrix([][]int{{12, 5}, {7, 9}})
+	tests := []struct {
+		name          string
+		table         *matrix.NumberMatrix[int]
+		options       []ChiSquareTestOptions
+		wantStatistic string
+		wantDF        int64
+		wantPValue    string
+		wantErr       bool
+	}{
+		{
+			name: "It should return Pearson's statistic by default", table: twoByThree,
+			wantStatistic: "4.5021645021645021645021645022", wantDF: 2, wantPValue: "0.1052852178400905422612775683",
+		},
+		{
+			name: "It should return the G statistic", table: twoByThree, options: []ChiSquareTestOptions{{Method: LikelihoodRatio}},
+			wantStatistic: "4.5698896752326482229443291642", wantDF: 2, wantPValue: "0.1017796763609923107839213988",
+		},
+		{
+			name: "It should return Pearson's statistic for a 2×2 table", table: twoByTwo,
+			wantStatistic: "2.4305755196815568332596196373", wantDF: 1, wantPValue: "0.118989205532",
+		},
+		{
+			name: "It should apply Yates' correction to a 2×2 table", table: twoByTwo, options: []ChiSquareTestOptions{{YatesCorrection: true}},
+			wantStatistic: "1.4559963788146837682441397612", wantDF: 1, wantPValue: "0.227568214576",
+		},
+		{
+			name: "It should apply Yates' correction to the G statistic", table: twoByTwo, options: []ChiSquareTestOptions{{Method: LikelihoodRatio, YatesCorrection: true}},
+			wantStatistic: "1.4660007253363233251906861284", wantDF: 1, wantPValue: "0.225977750722",
+		},
+		{name: "It should reject Yates' correction past 2×2", table: twoByThree, options: []ChiSquareTestOptions{{YatesCorrection: true}}, wantErr: true},
+		{name: "It should reject an unknown method", table: twoByTwo, options: []ChiSquareTestOptions{{Method: "exact"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IndependenceTest(tt.table, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("IndependenceTest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.DegreesOfFreedom != tt.wantDF {
+				t.Errorf("IndependenceTest() degrees of freedom = %d, want %d", got.DegreesOfFreedom, tt.wantDF)
+			}
+			if compare := bu.NewCompare(&got.Statistic, tt.wantStatistic); !compare.Equal() {
+				t.Errorf("IndependenceTest() statistic = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(&got.PValue, tt.wantPValue); !compare.Equal() {
+				t.Errorf("IndependenceTest() p-value = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_IndependenceTestExpected(t *testing.T) {
+	table, _ := matrix.NewBigMatrix([][]*big.Int{{big.NewInt(10), big.NewInt(20), big.NewInt(30)}, {big.NewInt(20), big.NewInt(15), big.NewInt(25)}})
+	got, err := IndependenceTest(table)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := [][]string{{"15", "17.5", "27.5"}, {"15", "17.5", "27.5"}}
+	rows := got.Expected.GetRows()
+	if len(rows) != len(want) {
+		t.Fatalf("expected %d rows, got %d", len(want), len(rows))
+	}
+	for i := range want {
+		for j := range want[i] {
+			if compare := bu.NewCompare(rows[i][j], want[i][j]); !compare.Equal() {
+				t.Errorf("expected[%d][%d] = %v, want %v", i, j, compare.ActualAsString, compare.Expected)
+			}
+		}
+	}
+}
+
+func Test_IndependenceTestRejectsBadTables(t *testing.T) {
+	negative, _ := matrix.NewNumberMatrix([][]float64{{1, -2}, {3, 4}})
+	fractional, _ := matrix.NewNumberMatrix([][]float64{{1, 2.5}, {3, 4}})
+	emptyRow, _ := matrix.NewNumberMatrix([][]float64{{0, 0}, {3, 4}})
+	singleRow, _ := matrix.NewNumberMatrix([][]float64{{1, 2, 3}})
+	for name, table := range map[string]*matrix.NumberMatrix[float64]{
+		"negative count":   negative,
+		"fractional count": fractional,
+		"row of zeros":     emptyRow,
+		"single row":       singleRow,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := IndependenceTest(table); err == nil {
+				t.Errorf("IndependenceTest() expected an error for a %s", name)
+			}
+		})
+	}
+}
+
+func Test_GoodnessOfFitTest(t *testing.T) {
+	row, _ := matrix.NewNumberMatrix([][]int{{18, 22, 20, 40}})
+	column, _ := matrix.NewNumberMatrix([][]int{{18}, {22}, {20}, {40}})
+	square, _ := matrix.NewNumberMatrix([][]int{{1, 2}, {3, 4}})
+	tests := []struct {
+		name          string
+		observed      *matrix.NumberMatrix[int]
+		probabilities []*big.Float
+		wantStatistic string
+		wantPValue    string
+		wantErr       bool
+	}{
+		{name: "It should default to equal probabilities", observed: row, wantStatistic: "12.32", wantPValue: "0.006363629995"},
+		{name: "It should accept a column of counts", observed: column, wantStatistic: "12.32", wantPValue: "0.006363629995"},
+		{
+			name: "It should use the given probabilities", observed: row,
+			probabilities: []*big.Float{bu.StrToFloat("0.2"), bu.StrToFloat("0.2"), bu.StrToFloat("0.2"), bu.StrToFloat("0.4")},
+			wantStatistic: "0.4", wantPValue: "0.940242494839",
+		},
+		{
+			name: "It should accept float64 probabilities that only sum to 1 in decimal", observed: row,
+			probabilities: []*big.Float{big.NewFloat(0.2), big.NewFloat(0.2), big.NewFloat(0.2), big.NewFloat(0.4)},
+			wantStatistic: "0.4", wantPValue: "0.940242494839",
+		},
+		{name: "It should reject a table", observed: square, wantErr: true},
+		{name: "It should reject mismatched probabilities", observed: row, probabilities: []*big.Float{bu.StrToFloat("0.2"), bu.StrToFloat("0.3"), bu.StrToFloat("0.5")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GoodnessOfFitTest(tt.observed, tt.probabilities)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GoodnessOfFitTest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.DegreesOfFreedom != 3 {
+				t.Errorf("GoodnessOfFitTest() degrees of freedom = %d, want 3", got.DegreesOfFreedom)
+			}
+			if got.Expected.Rows() != tt.observed.Rows() || got.Expected.Columns() != tt.observed.Columns() {
+				t.Errorf("GoodnessOfFitTest() expected counts are %d×%d, want %d×%d", got.Expected.Rows(), got.Expected.Columns(), tt.observed.Rows(), tt.observed.Columns())
+			}
+			if compare := bu.NewCompare(&got.Statistic, tt.wantStatistic); !compare.Equal() {
+				t.Errorf("GoodnessOfFitTest() statistic = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(&got.PValue, tt.wantPValue); !compare.Equal() {
+				t.Errorf("GoodnessOfFitTest() p-value = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -14,15 +14,79 @@ func CumulativeBinomialProbability(p *big.Float, n, k int64) (cumulative big.Flo
 	if n < k {
 		return big.Float{}, nil, errors.New("cumulative binomial probability n cannot be less than k")
 	}
+	one := bu.StrToFloat("1")
+	if p.Sign() == -1 || p.Cmp(one) > 0 {
+		return big.Float{}, nil, errors.New("cumulative binomial probability chance of success (p) must be between 0 and 1")
+	}
 	acc := bu.PrecFloat().SetInt64(0)
 	terms = make([]big.Float, 0, k+1)
-	for i := int64(0); i <= k; i++ {
-		prob, probErr := CalculateBinomialProbability(p, n, i)
-		if probErr != nil {
-			return big.Float{}, nil, probErr
+	// With p = 1 every term but P(X = n) is zero, and the ratio below would divide by zero
+	if p.Cmp(one) == 0 {
+		for i := int64(0); i <= k; i++ {
+			term := bu.PrecFloat().SetInt64(0)
+			if i == n {
+				term.SetInt64(1)
+			}
+			acc.Add(acc, term)
+			terms = append(terms, *term)
 		}
-		acc.Add(acc, &prob)
-		terms = append(terms, prob)
+		return *acc, terms, nil
+	}
+	// P(X = 0) = (1 - p)^n, and each later term follows from the one before it:
+	// P(X = i + 1) = P(X = i) * (n - i)/(i + 1) * p/(1 - p)
+	oneMinusP := bu.PrecFloat().Sub(one, p)
+	odds := bu.PrecFloat().Quo(p, oneMinusP)
+	term := IntPow(oneMinusP, big.NewInt(n))
+	for i := int64(0); i <= k; i++ {
+		acc.Add(acc, term)
+		terms = append(terms, *bu.PrecFloat().Copy(term))
+		ratio := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(n-i), bu.PrecFloat().SetInt64(i+1))
+		term = bu.PrecFloat().Mul(term, bu.PrecFloat().Mul(ratio, odds))
 	}
 	return *acc, terms, nil
 }
+
+// Above this many trials BinomialCDF switches from summing the exact terms to the incomplete beta function
+const binomialSummationLimit int64 = 10000
+
+// BinomialCDF returns P(X <= k) without the per-term breakdown, which lets it avoid the summation entirely for large n
+func BinomialCDF(p *big.Float, n, k int64) (cumulative big.Float, err error) {
+	if n <= binomialSummationLimit {
+		cumulative, _, err = CumulativeBinomialProbability(p, n, k)
+		return cumulative, err
+	}
+	left, _, err := binomialTailsIncompleteBeta(p, n, k)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *left, nil
+}
+
+// binomialTailsIncompleteBeta returns P(X <= k) = I_(1-p)(n - k, k + 1) and P(X >= k) = I_p(k, n - k + 1). Each tail
+// comes from its own continued fraction, so a tiny tail is not lost to 1 - (the other tail)
+func binomialTailsIncompleteBeta(p *big.Float, n, k int64) (left *big.Float, right *big.Float, err error) {
+	if k < 0 {
+		return nil, nil, errors.New("cumulative binomial probability k cannot be negative")
+	}
+	if n < k {
+		return nil, nil, errors.New("cumulative binomial probability n cannot be less than k")
+	}
+	one := bu.StrToFloat("1")
+	if p.Sign() == -1 || p.Cmp(one) > 0 {
+		return nil, nil, errors.New("cumulative binomial probability chance of success (p) must be between 0 and 1")
+	}
+	left, right = bu.PrecFloat().SetInt64(1), bu.PrecFloat().SetInt64(1)
+	if k < n {
+		left, err = RegularizedIncompleteBeta(bu.PrecFloat().Sub(one, p), bu.PrecFloat().SetInt64(n-k), bu.PrecFloat().SetInt64(k+1))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if k > 0 {
+		right, err = RegularizedIncompleteBeta(p, bu.PrecFloat().SetInt64(k), bu.PrecFloat().SetInt64(n-k+1))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return left, right, nil
+}
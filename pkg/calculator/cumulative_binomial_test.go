@@ -87,3 +87,111 @@ func Test_CumulativeBinomialProbabilityTermValues(t *testing.T) {
 		t.Errorf("terms[1] = %v, want 0.375", compare.ActualAsString)
 	}
 }
+
+func Test_BinomialCDF(t *testing.T) {
+	tests := []struct {
+		name    string
+		p       *big.Float
+		n, k    int64
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "small n sums the exact terms",
+			p:    bu.StrToFloat("0.5"), n: 3, k: 1,
+			want: "0.5",
+		},
+		{
+			name: "large n uses the incomplete beta function",
+			p:    bu.StrToFloat("0.5"), n: 500000, k: 250300,
+			want: "0.802321419998096933535277095531",
+		},
+		{
+			name: "k=n is certain",
+			p:    bu.StrToFloat("0.3"), n: 20000, k: 20000,
+			want: "1.0",
+		},
+		{
+			name: "large n with k < 0 returns error",
+			p:    bu.StrToFloat("0.5"), n: 20000, k: -1,
+			wantErr: true,
+		},
+		{
+			name: "large n with p > 1 returns error",
+			p:    bu.StrToFloat("1.5"), n: 20000, k: 10,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BinomialCDF(tt.p, tt.n, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BinomialCDF() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("BinomialCDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_binomialTailsIncompleteBetaMatchesSummation(t *testing.T) {
+	p := bu.StrToFloat("0.3")
+	summed, _, err := CumulativeBinomialProbability(p, 1000, 290)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	left, _, err := binomialTailsIncompleteBeta(p, 1000, 290)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(left, bu.ToStr(&summed, 30)); !compare.Equal() {
+		t.Errorf("binomialTailsIncompleteBeta() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_CumulativeBinomialProbabilityTermsMatchDirectEvaluation(t *testing.T) {
+	p := bu.StrToFloat("0.37")
+	_, terms, err := CumulativeBinomialProbability(p, 50, 50)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, term := range terms {
+		want, err := CalculateBinomialProbability(p, 50, int64(i))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		// The recurrence and the factorial form round differently, so compare relative to the term's size
+		difference := bu.PrecFloat().Sub(&term, &want)
+		if difference.Sign() != 0 && difference.MantExp(nil) > want.MantExp(nil)-200 {
+			t.Errorf("terms[%d] = %v, want %v", i, term.Text('g', 40), want.Text('g', 40))
+		}
+	}
+}
+
+func Test_CumulativeBinomialProbabilityDegenerateP(t *testing.T) {
+	tests := []struct {
+		name      string
+		p         string
+		k         int64
+		wantCumul string
+	}{
+		{name: "p=0 puts all mass on 0", p: "0", k: 0, wantCumul: "1.0"},
+		{name: "p=1 has no mass below n", p: "1", k: 4, wantCumul: "0.0"},
+		{name: "p=1 puts all mass on n", p: "1", k: 5, wantCumul: "1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cumulative, _, err := CumulativeBinomialProbability(bu.StrToFloat(tt.p), 5, tt.k)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&cumulative, tt.wantCumul); !compare.Equal() {
+				t.Errorf("CumulativeBinomialProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package calculator
+
+import (
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_CumulativeHypergeometricProbability(t *testing.T) {
+	tests := []struct {
+		name                            string
+		population, popSuccesses, draws int64
+		k                               int64
+		wantCumul                       string
+		wantTerms                       int
+		wantErr                         bool
+	}{
+		{
+			name:       "N=50, K=5, n=10, k=1",
+			population: 50, popSuccesses: 5, draws: 10, k: 1,
+			wantCumul: "0.741899979233",
+			wantTerms: 2,
+		},
+		{
+			name:       "N=20, K=7, n=12, k=4",
+			population: 20, popSuccesses: 7, draws: 12, k: 4,
+			wantCumul: "0.608359133127",
+			wantTerms: 5,
+		},
+		{
+			name:       "k=n sums to 1",
+			population: 20, popSuccesses: 7, draws: 12, k: 12,
+			wantCumul: "1.0",
+			wantTerms: 13,
+		},
+		{
+			name:       "k < 0 returns error",
+			population: 20, popSuccesses: 7, draws: 12, k: -1,
+			wantErr: true,
+		},
+		{
+			name:       "n < k returns error",
+			population: 20, popSuccesses: 7, draws: 12, k: 13,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cumulative, terms, err := CumulativeHypergeometricProbability(tt.population, tt.popSuccesses, tt.draws, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CumulativeHypergeometricProbability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(terms) != tt.wantTerms {
+				t.Errorf("expected %d terms, got %d", tt.wantTerms, len(terms))
+			}
+			if compare := bu.NewCompare(&cumulative, tt.wantCumul); !compare.Equal() {
+				t.Errorf("CumulativeHypergeometricProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func CumulativePoissonProbability(lambda *big.Float, k int64) (cumulative big.Float, terms []big.Float, err error) {
+	if k < 0 {
+		return big.Float{}, nil, errors.New("cumulative poisson probability k cannot be negative")
+	}
+	acc := bu.PrecFloat().SetInt64(0)
+	terms = make([]big.Float, 0, k+1)
+	for i := int64(0); i <= k; i++ {
+		prob, probErr := CalculatePoissonProbability(lambda, i)
+		if probErr != nil {
+			return big.Float{}, nil, probErr
+		}
+		acc.Add(acc, &prob)
+		terms = append(terms, prob)
+	}
+	return *acc, terms, nil
+}
@@ -0,0 +1,78 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_CumulativePoissonProbability(t *testing.T) {
+	tests := []struct {
+		name      string
+		lambda    *big.Float
+		k         int64
+		wantCumul string
+		wantTerms int
+		wantErr   bool
+	}{
+		{
+			name:   "k=0 returns P(X=0) only",
+			lambda: bu.StrToFloat("2"), k: 0,
+			wantCumul: "0.1353352832",
+			wantTerms: 1,
+		},
+		{
+			name:   "k=3 accumulates four terms",
+			lambda: bu.StrToFloat("2"), k: 3,
+			wantCumul: "0.8571234605",
+			wantTerms: 4,
+		},
+		{
+			name:   "lambda=3, k=5",
+			lambda: bu.StrToFloat("3"), k: 5,
+			wantCumul: "0.9160820580",
+			wantTerms: 6,
+		},
+		{
+			name:   "k < 0 returns error",
+			lambda: bu.StrToFloat("2"), k: -1,
+			wantErr: true,
+		},
+		{
+			name:   "lambda < 0 returns error",
+			lambda: bu.StrToFloat("-1"), k: 1,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cumulative, terms, err := CumulativePoissonProbability(tt.lambda, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CumulativePoissonProbability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(terms) != tt.wantTerms {
+				t.Errorf("expected %d terms, got %d", tt.wantTerms, len(terms))
+			}
+			if compare := bu.NewCompare(&cumulative, tt.wantCumul); !compare.Equal() {
+				t.Errorf("CumulativePoissonProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_CumulativePoissonProbabilityTermValues(t *testing.T) {
+	_, terms, err := CumulativePoissonProbability(bu.StrToFloat("2"), 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&terms[0], "0.1353352832"); !compare.Equal() {
+		t.Errorf("terms[0] = %v, want 0.1353352832", compare.ActualAsString)
+	}
+	if compare := bu.NewCompare(&terms[3], "0.1804470443"); !compare.Equal() {
+		t.Errorf("terms[3] = %v, want 0.1804470443", compare.ActualAsString)
+	}
+}
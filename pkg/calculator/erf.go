@@ -0,0 +1,100 @@
+package calculator
+
+import (
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// Past this point the series needs more terms than the continued fraction, and 1 - erf(x) starts throwing away
+// most of the digits of erfc(x)
+const erfSeriesEdge = "4"
+
+func Erf(x *big.Float) *big.Float {
+	if x.Sign() == 0 {
+		return bu.PrecFloat().SetInt64(0)
+	}
+	if x.Sign() == -1 {
+		return bu.PrecFloat().Neg(Erf(bu.PrecFloat().Neg(x)))
+	}
+	if x.Cmp(bu.StrToFloat(erfSeriesEdge)) >= 0 {
+		return bu.PrecFloat().Sub(bu.StrToFloat("1"), erfcContinuedFraction(x))
+	}
+	return erfSeries(x)
+}
+
+func Erfc(x *big.Float) *big.Float {
+	if x.Sign() == -1 {
+		return bu.PrecFloat().Add(bu.StrToFloat("1"), Erf(bu.PrecFloat().Neg(x)))
+	}
+	if x.Cmp(bu.StrToFloat(erfSeriesEdge)) >= 0 {
+		return erfcContinuedFraction(x)
+	}
+	return bu.PrecFloat().Sub(bu.StrToFloat("1"), erfSeries(x))
+}
+
+// erf(x) = 2/sqrt(pi) * e^(-x^2) * sum(2^n * x^(2n+1) / (1 * 3 * 5 * ... * (2n+1)))
+// Unlike the plain Maclaurin series every term is positive, so nothing cancels. Each term is the previous one
+// times 2x^2/(2n+1)
+func erfSeries(x *big.Float) *big.Float {
+	xSquared := bu.PrecFloat().Mul(x, x)
+	twoXSquared := bu.PrecFloat().Mul(bu.StrToFloat("2"), xSquared)
+	term := bu.PrecFloat().Copy(x)
+	sum := bu.PrecFloat().Copy(x)
+	for n := int64(1); ; n++ {
+		term.Mul(term, twoXSquared)
+		term.Quo(term, bu.PrecFloat().SetInt64(2*n+1))
+		if isNegligible(term, sum) {
+			break
+		}
+		sum.Add(sum, term)
+	}
+	eNegXSquared := Exp(bu.PrecFloat().Neg(xSquared))
+	twoOverSqrtPi := bu.PrecFloat().Quo(bu.StrToFloat("2"), bu.PrecFloat().Sqrt(Pi()))
+	return bu.PrecFloat().Mul(twoOverSqrtPi, bu.PrecFloat().Mul(eNegXSquared, sum))
+}
+
+// erfc(x) = e^(-x^2)/sqrt(pi) * 1/(x + (1/2)/(x + 1/(x + (3/2)/(x + 2/(x + ...))))), evaluated with the modified
+// Lentz algorithm. Only valid for positive x, and converges faster the larger x gets
+func erfcContinuedFraction(x *big.Float) *big.Float {
+	fraction := continuedFraction(x, func(n int64) (a, b *big.Float) {
+		return bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(n), bu.StrToFloat("2")), x
+	})
+	xSquared := bu.PrecFloat().Mul(x, x)
+	eNegXSquared := Exp(bu.PrecFloat().Neg(xSquared))
+	denominator := bu.PrecFloat().Mul(bu.PrecFloat().Sqrt(Pi()), fraction)
+	return bu.PrecFloat().Quo(eNegXSquared, denominator)
+}
+
+// continuedFraction evaluates b0 + a1/(b1 + a2/(b2 + ...)) with the modified Lentz algorithm, where terms(n) returns
+// a_n and b_n for n >= 1. It stops once a step no longer changes the value at the working precision
+func continuedFraction(b0 *big.Float, terms func(n int64) (a, b *big.Float), maxIterations ...int64) *big.Float {
+	iterations := int64(100000)
+	if len(maxIterations) > 0 {
+		iterations = maxIterations[0]
+	}
+	one := bu.StrToFloat("1")
+	// Lentz replaces exact zeros with a tiny value so the recurrences never divide by zero
+	tiny := bu.PrecFloat().SetMantExp(one, -2*int(one.Prec()))
+	nonZero := func(value *big.Float) *big.Float {
+		if value.Sign() == 0 {
+			return bu.PrecFloat().Copy(tiny)
+		}
+		return value
+	}
+	f := nonZero(bu.PrecFloat().Copy(b0))
+	c := bu.PrecFloat().Copy(f)
+	d := bu.PrecFloat().SetInt64(0)
+	for n := int64(1); n <= iterations; n++ {
+		a, b := terms(n)
+		d = nonZero(bu.PrecFloat().Add(b, bu.PrecFloat().Mul(a, d)))
+		d = bu.PrecFloat().Quo(one, d)
+		c = nonZero(bu.PrecFloat().Add(b, bu.PrecFloat().Quo(a, c)))
+		delta := bu.PrecFloat().Mul(c, d)
+		f.Mul(f, delta)
+		if isNegligible(bu.PrecFloat().Sub(delta, one), one) {
+			break
+		}
+	}
+	return f
+}
@@ -0,0 +1,58 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Erf(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *big.Float
+		want string
+	}{
+		{"It should return 0 for erf(0)", bu.StrToFloat("0"), "0.0"},
+		{"It should return 0.52049987781304653768 for erf(0.5)", bu.StrToFloat("0.5"), "0.52049987781304653768"},
+		{"It should return 0.84270079294971486934 for erf(1)", bu.StrToFloat("1"), "0.84270079294971486934"},
+		{"It should be odd, -0.93400794494065243660 for erf(-1.3)", bu.StrToFloat("-1.3"), "-0.93400794494065243660"},
+		{"It should return 0.99999999999846254021 for erf(5)", bu.StrToFloat("5"), "0.99999999999846254021"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if compare := bu.NewCompare(Erf(tt.x), tt.want); !compare.Equal() {
+				t.Errorf("Erf() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_Erfc(t *testing.T) {
+	tests := []struct {
+		name string
+		x    *big.Float
+		want string
+	}{
+		{"It should return 1 for erfc(0)", bu.StrToFloat("0"), "1.0"},
+		{"It should return 0.67137324054087257236 for erfc(0.3)", bu.StrToFloat("0.3"), "0.67137324054087257236"},
+		{"It should return 1.93400794494065243660 for erfc(-1.3)", bu.StrToFloat("-1.3"), "1.93400794494065243660"},
+		{
+			"It should keep relative precision in the tail, erfc(5) = 1.5374597944280348502e-12",
+			bu.StrToFloat("5"),
+			"0.0000000000015374597944280348502",
+		},
+		{
+			"It should keep relative precision far in the tail, erfc(10) = 2.088487583762544757e-45",
+			bu.StrToFloat("10"),
+			"0.000000000000000000000000000000000000000000002088487583762544757",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if compare := bu.NewCompare(Erfc(tt.x), tt.want); !compare.Equal() {
+				t.Errorf("Erfc() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -3,8 +3,10 @@ package calculator
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/big"
 	"strings"
+	"sync"
 
 	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
 	pade "github.com/ojsung/basic_stats_calculator/internal/pade"
@@ -13,9 +15,14 @@ import (
 var zero *big.Int = big.NewInt(0)
 var one *big.Int = big.NewInt(1)
 var two *big.Int = big.NewInt(2)
-var lnCache map[string]string = make(map[string]string)
-var eCache map[uint16]string = make(map[uint16]string)
-var taylorCache map[string]string = make(map[string]string)
+var lnCache = &stringCache[string]{data: make(map[string]string)}
+var eCache = &stringCache[uint16]{data: make(map[uint16]string), limit: 10}
+var taylorCache = &stringCache[string]{data: make(map[string]string)}
+
+var (
+	piMu    sync.Mutex
+	piCache string
+)
 
 const padeEdgeLow = "0.2"
 const padeEdgeHigh = "1.8"
@@ -79,21 +86,36 @@ func Exp(exponent *big.Float, maxIterations ...int64) *big.Float {
 	if exponent.Cmp(zero) == 0 {
 		return one
 	}
+	// The series below only behaves for small |x|. Negative exponents cancel catastrophically and large ones need
+	// thousands of terms, so reduce first: e^-x = 1/e^x, and e^x = (e^(x/2^s))^(2^s) with x/2^s <= 1
+	if exponent.Sign() == -1 {
+		return bu.PrecFloat().Quo(one, Exp(bu.PrecFloat().Neg(exponent), maxIterations...))
+	}
+	if exponent.Cmp(one) > 0 {
+		squarings := exponent.MantExp(nil)
+		power := Exp(bu.PrecFloat().SetMantExp(exponent, -squarings), maxIterations...)
+		for range squarings {
+			power.Mul(power, power)
+		}
+		return power
+	}
 	iterations := int64(200)
 	if len(maxIterations) > 0 {
 		iterations = maxIterations[0]
 	}
 	checkStart := iterations / 3
-	// 1 + x + x^2/2! + x^3/3! + ...
+	// 1 + x + x^2/2! + x^3/3! + ..., where each term is the previous one times x/i
 	power := bu.StrToFloat("0")
+	term := bu.PrecFloat().SetInt64(1)
 	for i := int64(0); i < iterations; i++ {
 		var original *big.Float
 		if i >= checkStart {
-			original = power
+			original = bu.PrecFloat().Copy(power)
+		}
+		if i > 0 {
+			term.Mul(term, exponent)
+			term.Quo(term, bu.PrecFloat().SetInt64(i))
 		}
-		numerator := IntPow(exponent, big.NewInt(i))
-		denominator, _ := Factorial(i)
-		term := numerator.Quo(numerator, bu.PrecFloat().SetInt(denominator))
 		power.Add(power, term)
 		if i >= checkStart && original.Cmp(power) == 0 {
 			break
@@ -103,7 +125,7 @@ func Exp(exponent *big.Float, maxIterations ...int64) *big.Float {
 }
 
 func Ln(argument *big.Float) (logarithm *big.Float, err error) {
-	if value, ok := lnCache[bu.ToStr(argument)]; ok {
+	if value, ok := lnCache.get(cacheKey(argument)); ok {
 		return bu.StrToFloat(value), nil
 	}
 	if argument.Cmp(bu.StrToFloat("0")) == 0 {
@@ -112,13 +134,16 @@ func Ln(argument *big.Float) (logarithm *big.Float, err error) {
 	if argument.Cmp(bu.StrToFloat("0")) == -1 {
 		return nil, errors.New("argument of natural log must be a positive, real number")
 	}
+	if argument.Cmp(bu.StrToFloat("1")) == 0 {
+		return bu.PrecFloat().SetInt64(0), nil
+	}
 	if argument.Cmp(bu.StrToFloat("2")) <= 0 {
 		if argument.Cmp(bu.StrToFloat(padeEdgeLow)) <= 0 || argument.Cmp(bu.StrToFloat(padeEdgeHigh)) > 0 {
 			logarithm, err = pade.ApproximateLn(argument)
 			if err != nil {
 				return nil, err
 			}
-			lnCache[bu.ToStr(argument)] = bu.ToStr(logarithm)
+			lnCache.set(cacheKey(argument), cacheValue(logarithm))
 			return
 		}
 		return taylorApproximationLn(argument)
@@ -138,7 +163,7 @@ func Ln(argument *big.Float) (logarithm *big.Float, err error) {
 		}
 		expDotLn2 := bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(int64(exp)), ln2)
 		logarithm = bu.PrecFloat().Add(lnMantissa, expDotLn2)
-		lnCache[bu.ToStr(argument)] = bu.ToStr(logarithm)
+		lnCache.set(cacheKey(argument), cacheValue(logarithm))
 		return
 	}
 }
@@ -153,7 +178,7 @@ func Euler(decimals ...uint16) (eulersNumber *big.Float) {
 	if places == 0 {
 		return bu.PrecFloat().SetInt64(3)
 	}
-	if value, ok := eCache[places]; ok {
+	if value, ok := eCache.get(places); ok {
 		return bu.StrToFloat(value)
 	}
 	minTerm, _ := new(big.Int).SetString(strings.Join([]string{"1", strings.Repeat("0", int(places))}, ""), 10)
@@ -170,13 +195,80 @@ func Euler(decimals ...uint16) (eulersNumber *big.Float) {
 			break
 		}
 	}
-	if len(eCache) > 10 {
-		clear(eCache)
+	eCache.set(places, bu.ToStr(eulersNumber))
+	return
+}
+
+func Pi() (pi *big.Float) {
+	piMu.Lock()
+	defer piMu.Unlock()
+	if piCache != "" {
+		return bu.StrToFloat(piCache)
 	}
-	eCache[places] = bu.ToStr(eulersNumber)
+	// Machin's formula, pi = 16 * arctan(1/5) - 4 * arctan(1/239), with each arctan from its Maclaurin series
+	pi = bu.PrecFloat().Sub(
+		bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(16), arctanReciprocal(5)),
+		bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(4), arctanReciprocal(239)),
+	)
+	piCache = cacheValue(pi)
 	return
 }
 
+// arctan(1/x) = 1/x - 1/(3x^3) + 1/(5x^5) - ...
+func arctanReciprocal(x int64) *big.Float {
+	sum := bu.PrecFloat().SetInt64(0)
+	power := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(1), bu.PrecFloat().SetInt64(x))
+	xSquared := bu.PrecFloat().SetInt64(x * x)
+	for i := int64(0); power.Sign() != 0; i++ {
+		term := bu.PrecFloat().Quo(power, bu.PrecFloat().SetInt64(2*i+1))
+		if isNegligible(term, sum) {
+			break
+		}
+		if i%2 == 0 {
+			sum.Add(sum, term)
+		} else {
+			sum.Sub(sum, term)
+		}
+		power.Quo(power, xSquared)
+	}
+	return sum
+}
+
+// Cache keys have to be exact. Rounding them to a fixed number of decimals makes every argument below 1e-16 share a
+// single entry
+func cacheKey(argument *big.Float) string {
+	return argument.Text('p', 0)
+}
+
+func cacheValue(value *big.Float) string {
+	return value.Text('g', int(value.Prec()))
+}
+
+// A term is negligible once adding it can no longer change the sum at the working precision
+func isNegligible(term *big.Float, sum *big.Float) bool {
+	if term.Sign() == 0 {
+		return true
+	}
+	if sum.Sign() == 0 {
+		return false
+	}
+	return term.MantExp(nil) < sum.MantExp(nil)-int(sum.Prec())
+}
+
+// Iterative solvers can't expect their last few bits to settle, so they stop once a step is this far below the
+// working precision
+const convergenceSlackBits = 16
+
+func isConverged(step *big.Float, value *big.Float) bool {
+	if step.Sign() == 0 {
+		return true
+	}
+	if value.Sign() == 0 {
+		return false
+	}
+	return step.MantExp(nil) < value.MantExp(nil)-int(value.Prec())+convergenceSlackBits
+}
+
 func taylorApproximationLn(argument *big.Float, maxIterations ...int64) (logarithm *big.Float, err error) {
 	if zero := bu.PrecFloat().SetInt64(0); argument.Cmp(zero) == -1 {
 		return nil, errors.New("argument must be a positive, real number")
@@ -187,7 +279,7 @@ func taylorApproximationLn(argument *big.Float, maxIterations ...int64) (logarit
 	if two := bu.PrecFloat().SetInt64(2); argument.Cmp(two) >= 0 {
 		return nil, errors.New("taylor approximation of natural log diverges for values of 2 or greater")
 	}
-	if value, ok := taylorCache[bu.ToStr(argument)]; ok {
+	if value, ok := taylorCache.get(cacheKey(argument)); ok {
 		return bu.StrToFloat(value), nil
 	}
 	// Because our taylor appx is for ln(x+1), we have to mutate our argument. Don't mutate the original, copy it
@@ -196,7 +288,14 @@ func taylorApproximationLn(argument *big.Float, maxIterations ...int64) (logarit
 	if len(maxIterations) > 0 {
 		iterations = maxIterations[0]
 	} else {
-		iterations = determineLeastIterations(argument)
+		// The iteration fit only covers (0, 1). ln(1 + u) converges at the same rate for u and -u, so reflect about 1
+		fitArgument := argument
+		if argument.Cmp(bu.StrToFloat("1")) > 0 {
+			fitArgument = bu.PrecFloat().Sub(bu.StrToFloat("2"), argument)
+		}
+		// The fit targets ~16 digits, which is too few terms once |u| gets large. Take at least enough terms for
+		// |u|^n / n to drop below the working precision; the convergence check below still exits early
+		iterations = max(determineLeastIterations(fitArgument), iterationsForPrecision(adjArgument))
 	}
 	if iterations < 1 {
 		return nil, errors.New("maxIterations must be at least 1")
@@ -228,14 +327,21 @@ func taylorApproximationLn(argument *big.Float, maxIterations ...int64) (logarit
 		}
 		// After the first twenty iterations, start checking if we've hit the limit of our precision. Break if we did
 		if i > checkStart && original.Cmp(logarithm) == 0 {
-			fmt.Sprintln("Stopping at", logarithm)
 			break
 		}
 	}
-	taylorCache[bu.ToStr(argument)] = bu.ToStr(logarithm)
+	taylorCache.set(cacheKey(argument), cacheValue(logarithm))
 	return logarithm, nil
 }
 
+func iterationsForPrecision(adjArgument *big.Float) int64 {
+	u, _ := adjArgument.Float64()
+	if u == 0 {
+		return 1
+	}
+	return int64(math.Ceil(float64(adjArgument.Prec())/-math.Log2(math.Abs(u)))) + 1
+}
+
 func determineLeastIterations(argument *big.Float) int64 {
 	abs := bu.PrecFloat().Abs(argument)
 	if abs.Cmp(bu.StrToFloat("0.8")) < 0 {
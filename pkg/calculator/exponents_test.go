@@ -143,6 +143,16 @@ func Test_Exp(t *testing.T) {
 			maxIterations: 100,
 			want:          "1.6487212707001281",
 		},
+		{
+			name:     "It should return 3.720075976020836e-44 for e^-100",
+			exponent: bu.PrecFloat().SetInt64(-100),
+			want:     "0.00000000000000000000000000000000000000000003720075976020836",
+		},
+		{
+			name:     "It should return 0.74081822068171786607 for e^-0.3",
+			exponent: bu.StrToFloat("-0.3"),
+			want:     "0.74081822068171786607",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -213,6 +223,36 @@ func Test_Ln(t *testing.T) {
 			want:     "0.6418538861723948",
 			wantErr:  false,
 		},
+		{
+			name:     "It should return 0.4054651081081644 for ln(1.5)",
+			argument: bu.StrToFloat("1.5"),
+			want:     "0.4054651081081644",
+			wantErr:  false,
+		},
+		{
+			name:     "It should return -1.20397280432593599262274621776 for ln(0.3)",
+			argument: bu.StrToFloat("0.3"),
+			want:     "-1.20397280432593599262274621776",
+			wantErr:  false,
+		},
+		{
+			name:     "It should return 0 for ln(1)",
+			argument: bu.StrToFloat("1"),
+			want:     "0.0",
+			wantErr:  false,
+		},
+		{
+			name:     "It should return -46.0517018598809136803598 for ln(1e-20)",
+			argument: bu.StrToFloat("1e-20"),
+			want:     "-46.0517018598809136803598",
+			wantErr:  false,
+		},
+		{
+			name:     "It should not reuse the cached ln(1e-20) for ln(3e-20)",
+			argument: bu.StrToFloat("3e-20"),
+			want:     "-44.9530895712128039889646",
+			wantErr:  false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -429,3 +469,14 @@ func Test_FloatPow(t *testing.T) {
 		})
 	}
 }
+
+func Test_Pi(t *testing.T) {
+	want := "3.1415926535897932384626433832795028841971693993751"
+	if compare := bu.NewCompare(Pi(), want); !compare.Equal() {
+		t.Errorf("Pi() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	// Second call comes from the cache
+	if compare := bu.NewCompare(Pi(), want); !compare.Equal() {
+		t.Errorf("cached Pi() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
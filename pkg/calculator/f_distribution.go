@@ -0,0 +1,109 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func validateFDegreesOfFreedom(numeratorDf, denominatorDf *big.Float) error {
+	if numeratorDf.Sign() != 1 || denominatorDf.Sign() != 1 {
+		return errors.New("f distribution degrees of freedom (d1, d2) must be positive")
+	}
+	return nil
+}
+
+func FPDF(x, numeratorDf, denominatorDf *big.Float) (density big.Float, err error) {
+	if err := validateFDegreesOfFreedom(numeratorDf, denominatorDf); err != nil {
+		return big.Float{}, err
+	}
+	if x.Sign() != 1 {
+		return *bu.PrecFloat().SetInt64(0), nil
+	}
+	// f(x) = sqrt((d1 x)^d1 * d2^d2 / (d1 x + d2)^(d1 + d2)) / (x * B(d1/2, d2/2))
+	half := bu.StrToFloat("0.5")
+	d1X := bu.PrecFloat().Mul(numeratorDf, x)
+	lnD1X, err := Ln(d1X)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnD2, err := Ln(denominatorDf)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnSum, err := Ln(bu.PrecFloat().Add(d1X, denominatorDf))
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnX, err := Ln(x)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnBetaHalves, err := lnBeta(bu.PrecFloat().Mul(numeratorDf, half), bu.PrecFloat().Mul(denominatorDf, half))
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnDensity := bu.PrecFloat().Add(bu.PrecFloat().Mul(numeratorDf, lnD1X), bu.PrecFloat().Mul(denominatorDf, lnD2))
+	lnDensity.Sub(lnDensity, bu.PrecFloat().Mul(bu.PrecFloat().Add(numeratorDf, denominatorDf), lnSum))
+	lnDensity.Mul(lnDensity, half)
+	lnDensity.Sub(lnDensity, lnX)
+	lnDensity.Sub(lnDensity, lnBetaHalves)
+	return *Exp(lnDensity), nil
+}
+
+// P(X <= x) = I_(d1 x/(d1 x + d2))(d1/2, d2/2), and the upper tail is I_(d2/(d1 x + d2))(d2/2, d1/2)
+func fTails(x, numeratorDf, denominatorDf *big.Float) (left *big.Float, right *big.Float, err error) {
+	if err := validateFDegreesOfFreedom(numeratorDf, denominatorDf); err != nil {
+		return nil, nil, err
+	}
+	if x.Sign() != 1 {
+		return bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(1), nil
+	}
+	half := bu.StrToFloat("0.5")
+	d1X := bu.PrecFloat().Mul(numeratorDf, x)
+	total := bu.PrecFloat().Add(d1X, denominatorDf)
+	left, err = RegularizedIncompleteBeta(bu.PrecFloat().Quo(d1X, total), bu.PrecFloat().Mul(numeratorDf, half), bu.PrecFloat().Mul(denominatorDf, half))
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err = RegularizedIncompleteBeta(bu.PrecFloat().Quo(denominatorDf, total), bu.PrecFloat().Mul(denominatorDf, half), bu.PrecFloat().Mul(numeratorDf, half))
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func FCDF(x, numeratorDf, denominatorDf *big.Float) (cumulative big.Float, err error) {
+	left, _, err := fTails(x, numeratorDf, denominatorDf)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *left, nil
+}
+
+func FPValue(x, numeratorDf, denominatorDf *big.Float, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	left, right, err := fTails(x, numeratorDf, denominatorDf)
+	if err != nil {
+		return big.Float{}, err
+	}
+	switch tail {
+	case "left":
+		return *left, nil
+	case "right":
+		return *right, nil
+	}
+	return doubleSmallerTail(left, right), nil
+}
+
+func FQuantile(probability, numeratorDf, denominatorDf *big.Float) (quantile big.Float, err error) {
+	if err := validateFDegreesOfFreedom(numeratorDf, denominatorDf); err != nil {
+		return big.Float{}, err
+	}
+	cdf := func(x *big.Float) (big.Float, error) { return FCDF(x, numeratorDf, denominatorDf) }
+	pdf := func(x *big.Float) (big.Float, error) { return FPDF(x, numeratorDf, denominatorDf) }
+	return invertCDF(probability, bu.StrToFloat("1"), bu.PrecFloat().SetInt64(0), nil, cdf, pdf)
+}
@@ -0,0 +1,81 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_FPDF(t *testing.T) {
+	got, err := FPDF(bu.StrToFloat("1.5"), bu.StrToFloat("4"), bu.StrToFloat("6"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&got, "0.25"); !compare.Equal() {
+		t.Errorf("FPDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, err := FPDF(bu.StrToFloat("1"), bu.StrToFloat("0"), bu.StrToFloat("6")); err == nil {
+		t.Error("expected error for zero degrees of freedom")
+	}
+}
+
+func Test_FCDF(t *testing.T) {
+	got, err := FCDF(bu.StrToFloat("3"), bu.StrToFloat("3"), bu.StrToFloat("10"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&got, "0.918253048190175"); !compare.Equal() {
+		t.Errorf("FCDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_FPValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{name: "right tail", tail: "right", want: "0.081746951809825"},
+		{name: "left tail", tail: "left", want: "0.918253048190175"},
+		{name: "two tails", tail: "two", want: "0.163493903619649"},
+		{name: "invalid tail returns error", tail: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FPValue(bu.StrToFloat("3"), bu.StrToFloat("3"), bu.StrToFloat("10"), tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("FPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_FQuantile(t *testing.T) {
+	tests := []struct {
+		name                                    string
+		probability, numeratorDf, denominatorDf *big.Float
+		want                                    string
+	}{
+		{name: "95% critical value", probability: bu.StrToFloat("0.95"), numeratorDf: bu.StrToFloat("3"), denominatorDf: bu.StrToFloat("10"), want: "3.708264819046844"},
+		{name: "lower tail", probability: bu.StrToFloat("0.05"), numeratorDf: bu.StrToFloat("5"), denominatorDf: bu.StrToFloat("2"), want: "0.172826937585791"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FQuantile(tt.probability, tt.numeratorDf, tt.denominatorDf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("FQuantile() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// FisherExactTest tests independence in the 2x2 table
+//
+//	| a | b |
+//	| c | d |
+//
+// With the margins fixed, a follows a hypergeometric distribution. "left" and "right" are P(X <= a) and P(X >= a).
+// "two" sums every outcome no more likely than the observed one, which is the convention R's fisher.test uses
+func FisherExactTest(table [2][2]int64, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	for _, row := range table {
+		for _, count := range row {
+			if count < 0 {
+				return big.Float{}, errors.New("fisher exact test counts cannot be negative")
+			}
+		}
+	}
+	observed := table[0][0]
+	rowTotal := table[0][0] + table[0][1]
+	columnTotal := table[0][0] + table[1][0]
+	population := rowTotal + table[1][0] + table[1][1]
+	low := max(0, columnTotal-(population-rowTotal))
+	high := min(rowTotal, columnTotal)
+
+	observedNumerator, err := hypergeometricNumerator(population, rowTotal, columnTotal, observed)
+	if err != nil {
+		return big.Float{}, err
+	}
+	tailSum := big.NewInt(0)
+	for x := low; x <= high; x++ {
+		numerator, err := hypergeometricNumerator(population, rowTotal, columnTotal, x)
+		if err != nil {
+			return big.Float{}, err
+		}
+		switch {
+		case tail == "left" && x <= observed,
+			tail == "right" && x >= observed,
+			tail == "two" && numerator.Cmp(observedNumerator) <= 0:
+			tailSum.Add(tailSum, numerator)
+		}
+	}
+	denominator, err := calculateBinomialCoefficient(population, columnTotal)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *bu.PrecFloat().Quo(bu.PrecFloat().SetInt(tailSum), bu.PrecFloat().SetInt(denominator)), nil
+}
@@ -0,0 +1,78 @@
+package calculator
+
+import (
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_FisherExactTest(t *testing.T) {
+	teaTasting := [2][2]int64{{3, 1}, {1, 3}}
+	tests := []struct {
+		name    string
+		table   [2][2]int64
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "tea tasting left tail",
+			table: teaTasting, tail: "left",
+			want: "0.985714285714",
+		},
+		{
+			name:  "tea tasting right tail",
+			table: teaTasting, tail: "right",
+			want: "0.242857142857",
+		},
+		{
+			name:  "tea tasting two-tailed",
+			table: teaTasting, tail: "two",
+			want: "0.485714285714",
+		},
+		{
+			name:  "two-tailed sums outcomes no more likely than observed",
+			table: [2][2]int64{{1, 9}, {11, 3}}, tail: "two",
+			want: "0.002759456185",
+		},
+		{
+			name:  "asymmetric table right tail",
+			table: [2][2]int64{{10, 2}, {3, 15}}, tail: "right",
+			want: "0.000465180943",
+		},
+		{
+			name:  "asymmetric table two-tailed",
+			table: [2][2]int64{{10, 2}, {3, 15}}, tail: "two",
+			want: "0.000536724119",
+		},
+		{
+			name:  "perfect separation two-tailed",
+			table: [2][2]int64{{0, 5}, {5, 0}}, tail: "two",
+			want: "0.007936507937",
+		},
+		{
+			name:  "invalid tail returns error",
+			table: teaTasting, tail: "center",
+			wantErr: true,
+		},
+		{
+			name:  "negative count returns error",
+			table: [2][2]int64{{3, -1}, {1, 3}}, tail: "two",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pval, err := FisherExactTest(tt.table, tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FisherExactTest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&pval, tt.want); !compare.Equal() {
+				t.Errorf("FisherExactTest() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"sync"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+var (
+	bernoulliMu    sync.Mutex
+	bernoulliCache = []*big.Rat{big.NewRat(1, 1)}
+)
+
+func Gamma(argument *big.Float) (gamma *big.Float, err error) {
+	if argument.IsInt() && argument.Sign() != 1 {
+		return nil, errors.New("gamma function is not defined at zero or negative integers")
+	}
+	// Γ(n) = (n - 1)! exactly, as long as the factorial stays a reasonable size
+	if argument.IsInt() && argument.Cmp(bu.StrToFloat("1000")) <= 0 {
+		n, _ := argument.Int64()
+		factorial, err := Factorial(n - 1)
+		if err != nil {
+			return nil, err
+		}
+		return bu.PrecFloat().SetInt(factorial), nil
+	}
+	// Γ(x) = Γ(x + n) / (x(x + 1)...(x + n - 1)) lifts negative arguments into the domain of LogGamma
+	shifted := bu.PrecFloat().Copy(argument)
+	product := bu.PrecFloat().SetInt64(1)
+	for shifted.Sign() != 1 {
+		product.Mul(product, shifted)
+		shifted.Add(shifted, bu.StrToFloat("1"))
+	}
+	logGamma, err := LogGamma(shifted)
+	if err != nil {
+		return nil, err
+	}
+	return bu.PrecFloat().Quo(Exp(logGamma), product), nil
+}
+
+// LogGamma returns ln Γ(x) for x > 0. It is finite long after Γ(x) itself stops fitting in anything reasonable, so
+// ratios of gamma functions should be taken as differences of LogGamma
+func LogGamma(argument *big.Float) (logGamma *big.Float, err error) {
+	if argument.Sign() != 1 {
+		return nil, errors.New("log gamma argument (x) must be positive")
+	}
+	if argument.IsInt() && argument.Cmp(bu.StrToFloat("1000")) <= 0 {
+		gamma, err := Gamma(argument)
+		if err != nil {
+			return nil, err
+		}
+		return Ln(gamma)
+	}
+	// The Stirling series is asymptotic: its smallest term is about e^(-2πx), so x is first raised until that is
+	// below the working precision, using ln Γ(x) = ln Γ(x + n) - ln(x(x + 1)...(x + n - 1))
+	prec := argument.Prec()
+	if prec == 0 || prec < bu.PrecFloat().Prec() {
+		prec = bu.PrecFloat().Prec()
+	}
+	threshold := bu.PrecFloat().SetInt64(int64(float64(prec)*math.Ln2/(2*math.Pi)) + 8)
+	x := bu.PrecFloat().Copy(argument)
+	var shiftProduct *big.Float
+	if x.Cmp(threshold) < 0 {
+		shiftProduct = bu.PrecFloat().SetInt64(1)
+		for x.Cmp(threshold) < 0 {
+			shiftProduct.Mul(shiftProduct, x)
+			x.Add(x, bu.StrToFloat("1"))
+		}
+	}
+
+	logGamma, err = stirlingLogGamma(x, prec)
+	if err != nil {
+		return nil, err
+	}
+	if shiftProduct != nil {
+		lnShift, err := Ln(shiftProduct)
+		if err != nil {
+			return nil, err
+		}
+		logGamma.Sub(logGamma, lnShift)
+	}
+	return logGamma, nil
+}
+
+// ln Γ(x) ~ (x - 1/2) ln x - x + ln(2π)/2 + Σ B_2k / (2k(2k - 1) x^(2k - 1))
+func stirlingLogGamma(x *big.Float, prec uint) (*big.Float, error) {
+	lnX, err := Ln(x)
+	if err != nil {
+		return nil, err
+	}
+	lnTwoPi, err := Ln(bu.PrecFloat().Mul(Pi(), bu.StrToFloat("2")))
+	if err != nil {
+		return nil, err
+	}
+	sum := bu.PrecFloat().Mul(bu.PrecFloat().Sub(x, bu.StrToFloat("0.5")), lnX)
+	sum.Sub(sum, x)
+	sum.Add(sum, bu.PrecFloat().Quo(lnTwoPi, bu.StrToFloat("2")))
+
+	xSquared := bu.PrecFloat().Mul(x, x)
+	power := bu.PrecFloat().Copy(x)
+	var previous *big.Float
+	for k := int64(1); ; k++ {
+		term := bu.PrecFloat().SetRat(bernoulli(2 * k))
+		term.Quo(term, bu.PrecFloat().SetInt64(2*k*(2*k-1)))
+		term.Quo(term, power)
+		if isNegligible(term, sum) {
+			return sum, nil
+		}
+		// Past its smallest term the series diverges, which the shift above is meant to prevent
+		if previous != nil && bu.PrecFloat().Abs(term).Cmp(previous) >= 0 {
+			return nil, errors.New("stirling series for log gamma did not reach the working precision")
+		}
+		sum.Add(sum, term)
+		previous = bu.PrecFloat().Abs(term)
+		power.Mul(power, xSquared)
+	}
+}
+
+// bernoulli returns B_n from B_m = -1/(m + 1) * Σ_(j<m) C(m + 1, j) B_j, caching every value along the way
+func bernoulli(n int64) *big.Rat {
+	bernoulliMu.Lock()
+	defer bernoulliMu.Unlock()
+	for m := int64(len(bernoulliCache)); m <= n; m++ {
+		sum := new(big.Rat)
+		coefficient := big.NewInt(1)
+		for j := int64(0); j < m; j++ {
+			sum.Add(sum, new(big.Rat).Mul(new(big.Rat).SetInt(coefficient), bernoulliCache[j]))
+			// C(m + 1, j + 1) = C(m + 1, j) * (m + 1 - j) / (j + 1)
+			coefficient.Mul(coefficient, big.NewInt(m+1-j))
+			coefficient.Quo(coefficient, big.NewInt(j+1))
+		}
+		bernoulliCache = append(bernoulliCache, sum.Neg(sum.Quo(sum, new(big.Rat).SetInt64(m+1))))
+	}
+	return bernoulliCache[n]
+}
+
+// LogBinomialCoefficient returns ln C(n, k) = ln Γ(n + 1) - ln Γ(k + 1) - ln Γ(n - k + 1), which also covers
+// non-integer n and k
+func LogBinomialCoefficient(n, k *big.Float) (logCoefficient *big.Float, err error) {
+	nMinusK := bu.PrecFloat().Sub(n, k)
+	if k.Sign() == -1 || nMinusK.Sign() == -1 {
+		return nil, errors.New("binomial coefficient requires 0 <= k (k) <= n (n)")
+	}
+	one := bu.StrToFloat("1")
+	lnN, err := LogGamma(bu.PrecFloat().Add(n, one))
+	if err != nil {
+		return nil, err
+	}
+	lnK, err := LogGamma(bu.PrecFloat().Add(k, one))
+	if err != nil {
+		return nil, err
+	}
+	lnNMinusK, err := LogGamma(bu.PrecFloat().Add(nMinusK, one))
+	if err != nil {
+		return nil, err
+	}
+	return bu.PrecFloat().Sub(bu.PrecFloat().Sub(lnN, lnK), lnNMinusK), nil
+}
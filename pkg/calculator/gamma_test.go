@@ -0,0 +1,82 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Gamma(t *testing.T) {
+	tests := []struct {
+		name     string
+		argument *big.Float
+		want     string
+		wantErr  bool
+	}{
+		{name: "It should match the factorial for integers", argument: bu.StrToFloat("5"), want: "24"},
+		{name: "It should return sqrt(pi) for 1/2", argument: bu.StrToFloat("0.5"), want: "1.772453850905516027298167483341"},
+		{name: "It should handle small non-integer arguments", argument: bu.StrToFloat("0.1"), want: "9.513507698668731836292487177265"},
+		{name: "It should handle negative non-integer arguments", argument: bu.StrToFloat("-2.5"), want: "-0.945308720482941881225689324449"},
+		{name: "It should reject zero", argument: bu.StrToFloat("0"), wantErr: true},
+		{name: "It should reject negative integers", argument: bu.StrToFloat("-3"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Gamma(tt.argument)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Gamma() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("Gamma() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_LogGamma(t *testing.T) {
+	tests := []struct {
+		name     string
+		argument *big.Float
+		want     string
+		wantErr  bool
+	}{
+		{name: "It should return 0 for 1", argument: bu.StrToFloat("1"), want: "0.0"},
+		{name: "It should return ln(sqrt(pi)) for 1/2", argument: bu.StrToFloat("0.5"), want: "0.572364942924700087071713675677"},
+		{name: "It should handle non-integers above the shift threshold", argument: bu.StrToFloat("100.5"), want: "361.435540467777621555251912703"},
+		{name: "It should handle arguments in the millions", argument: bu.StrToFloat("1000000"), want: "12815504.569147611659976971785"},
+		{name: "It should handle arguments near zero", argument: bu.StrToFloat("1e-30"), want: "69.077552789821370520539743641"},
+		{name: "It should reject non-positive arguments", argument: bu.StrToFloat("-0.5"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LogGamma(tt.argument)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LogGamma() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("LogGamma() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_LogBinomialCoefficient(t *testing.T) {
+	got, err := LogBinomialCoefficient(bu.StrToFloat("10"), bu.StrToFloat("3"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// ln(120)
+	if compare := bu.NewCompare(got, "4.787491742782045994248"); !compare.Equal() {
+		t.Errorf("LogBinomialCoefficient() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, err := LogBinomialCoefficient(bu.StrToFloat("3"), bu.StrToFloat("4")); err == nil {
+		t.Error("expected error for k greater than n")
+	}
+}
@@ -0,0 +1,21 @@
+package calculator
+
+import "math/big"
+
+// GeometricProbability is the negative binomial with r = 1: the failures before, or the trials up to and including,
+// the first success. The other geometric functions wrap their negative binomial counterparts the same way
+func GeometricProbability(p *big.Float, x int64, parameterisation NegativeBinomialParameterisation) (probability big.Float, err error) {
+	return NegativeBinomialProbability(p, 1, x, parameterisation)
+}
+
+func CumulativeGeometricProbability(p *big.Float, x int64, parameterisation NegativeBinomialParameterisation) (cumulative big.Float, terms []big.Float, err error) {
+	return CumulativeNegativeBinomialProbability(p, 1, x, parameterisation)
+}
+
+func GeometricPValue(p *big.Float, x int64, parameterisation NegativeBinomialParameterisation, tail string) (pValue big.Float, err error) {
+	return NegativeBinomialPValue(p, 1, x, parameterisation, tail)
+}
+
+func GeometricQuantile(p *big.Float, alpha *big.Float, parameterisation NegativeBinomialParameterisation) (x int64, cumulative big.Float, err error) {
+	return NegativeBinomialQuantile(p, 1, alpha, parameterisation)
+}
@@ -0,0 +1,63 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_GeometricProbability(t *testing.T) {
+	// (1 - p)^3 * p for three failures, or equivalently a first success on the fourth trial
+	failures, err := GeometricProbability(bu.StrToFloat("0.2"), 3, NegativeBinomialFailures)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	trials, err := GeometricProbability(bu.StrToFloat("0.2"), 4, NegativeBinomialTrials)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, got := range []*big.Float{&failures, &trials} {
+		if compare := bu.NewCompare(got, "0.1024"); !compare.Equal() {
+			t.Errorf("GeometricProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+		}
+	}
+}
+
+func Test_CumulativeGeometricProbability(t *testing.T) {
+	// 1 - (1 - p)^4
+	cumulative, terms, err := CumulativeGeometricProbability(bu.StrToFloat("0.2"), 3, NegativeBinomialFailures)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(terms) != 4 {
+		t.Errorf("expected 4 terms, got %d", len(terms))
+	}
+	if compare := bu.NewCompare(&cumulative, "0.5904"); !compare.Equal() {
+		t.Errorf("CumulativeGeometricProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_GeometricPValue(t *testing.T) {
+	// P(X >= 4 trials) = (1 - p)^3
+	got, err := GeometricPValue(bu.StrToFloat("0.2"), 4, NegativeBinomialTrials, "right")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&got, "0.512"); !compare.Equal() {
+		t.Errorf("GeometricPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_GeometricQuantile(t *testing.T) {
+	x, cumulative, err := GeometricQuantile(bu.StrToFloat("0.2"), bu.StrToFloat("0.5"), NegativeBinomialTrials)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if x != 4 {
+		t.Errorf("GeometricQuantile() x = %d, want 4", x)
+	}
+	if compare := bu.NewCompare(&cumulative, "0.5904"); !compare.Equal() {
+		t.Errorf("GeometricQuantile() cumulative = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
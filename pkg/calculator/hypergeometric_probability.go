@@ -0,0 +1,51 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func CalculateHypergeometricProbability(population, populationSuccesses, draws, successes int64) (probability big.Float, err error) {
+	numerator, err := hypergeometricNumerator(population, populationSuccesses, draws, successes)
+	if err != nil {
+		return big.Float{}, err
+	}
+	denominator, err := calculateBinomialCoefficient(population, draws)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *bu.PrecFloat().Quo(bu.PrecFloat().SetInt(numerator), bu.PrecFloat().SetInt(denominator)), nil
+}
+
+// P(X = k) = C(K, k) * C(N - K, n - k) / C(N, n). Every outcome shares the C(N, n) denominator, so the numerator
+// alone is enough to rank outcomes by likelihood exactly
+func hypergeometricNumerator(population, populationSuccesses, draws, successes int64) (numerator Int, err error) {
+	if population < 0 {
+		return nil, errors.New("hypergeometric probability population (N) cannot be negative")
+	}
+	if populationSuccesses < 0 || populationSuccesses > population {
+		return nil, errors.New("hypergeometric probability population successes (K) must be between 0 and population (N)")
+	}
+	if draws < 0 || draws > population {
+		return nil, errors.New("hypergeometric probability draws (n) must be between 0 and population (N)")
+	}
+	if successes < 0 {
+		return nil, errors.New("hypergeometric probability successes (k) cannot be negative")
+	}
+	populationFailures := population - populationSuccesses
+	failures := draws - successes
+	if successes > populationSuccesses || failures < 0 || failures > populationFailures {
+		return big.NewInt(0), nil
+	}
+	successWays, err := calculateBinomialCoefficient(populationSuccesses, successes)
+	if err != nil {
+		return nil, err
+	}
+	failureWays, err := calculateBinomialCoefficient(populationFailures, failures)
+	if err != nil {
+		return nil, err
+	}
+	return successWays.Mul(successWays, failureWays), nil
+}
@@ -0,0 +1,92 @@
+package calculator
+
+import (
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_CalculateHypergeometricProbability(t *testing.T) {
+	type args = struct {
+		population          int64
+		populationSuccesses int64
+		draws               int64
+		successes           int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "It should return 0.003964583058 for N=50, K=5, n=10, k=4",
+			args: args{50, 5, 10, 4},
+			want: "0.003964583058",
+		},
+		{
+			name: "It should return 0.357585139319 for N=20, K=7, n=12, k=4",
+			args: args{20, 7, 12, 4},
+			want: "0.357585139319",
+		},
+		{
+			name: "It should return 0.083333333333 for N=10, K=3, n=5, k=0",
+			args: args{10, 3, 5, 0},
+			want: "0.083333333333",
+		},
+		{
+			name: "It should return 0 when k exceeds the population successes",
+			args: args{10, 3, 5, 4},
+			want: "0.0",
+		},
+		{
+			name: "It should return 0 when the failures drawn exceed the population failures",
+			args: args{10, 8, 5, 0},
+			want: "0.0",
+		},
+		{
+			name:    "It should return an error when K > N",
+			args:    args{10, 11, 5, 2},
+			wantErr: true,
+		},
+		{
+			name:    "It should return an error when n > N",
+			args:    args{10, 3, 11, 2},
+			wantErr: true,
+		},
+		{
+			name:    "It should return an error when k < 0",
+			args:    args{10, 3, 5, -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateHypergeometricProbability(tt.args.population, tt.args.populationSuccesses, tt.args.draws, tt.args.successes)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateHypergeometricProbability() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("CalculateHypergeometricProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_HypergeometricProbabilitySumsToOne(t *testing.T) {
+	sum := bu.PrecFloat()
+	for k := int64(0); k <= 12; k++ {
+		prob, err := CalculateHypergeometricProbability(20, 7, 12, k)
+		if err != nil {
+			t.Fatalf("unexpected error at k=%d: %v", k, err)
+		}
+		sum.Add(sum, &prob)
+	}
+	if compare := bu.NewCompare(sum, "1.000000000"); !compare.Equal() {
+		t.Errorf("probabilities do not sum to 1: got %v", compare.ActualAsString)
+	}
+}
@@ -0,0 +1,134 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// I_x(a, b) = B(x; a, b) / B(a, b), the probability that a Beta(a, b) variable is at most x
+func RegularizedIncompleteBeta(x, a, b *big.Float) (beta *big.Float, err error) {
+	if a.Sign() != 1 || b.Sign() != 1 {
+		return nil, errors.New("incomplete beta shapes (a, b) must be positive")
+	}
+	one := bu.StrToFloat("1")
+	if x.Sign() == -1 || x.Cmp(one) > 0 {
+		return nil, errors.New("incomplete beta argument (x) must be between 0 and 1")
+	}
+	if x.Sign() == 0 {
+		return bu.PrecFloat().SetInt64(0), nil
+	}
+	if x.Cmp(one) == 0 {
+		return bu.PrecFloat().SetInt64(1), nil
+	}
+	// The continued fraction converges quickly below the mean. Above it, use I_x(a, b) = 1 - I_(1-x)(b, a)
+	aPlusOne := bu.PrecFloat().Add(a, one)
+	aPlusBPlusTwo := bu.PrecFloat().Add(bu.PrecFloat().Add(a, b), bu.StrToFloat("2"))
+	if x.Cmp(bu.PrecFloat().Quo(aPlusOne, aPlusBPlusTwo)) > 0 {
+		complement, err := incompleteBetaContinuedFraction(bu.PrecFloat().Sub(one, x), b, a)
+		if err != nil {
+			return nil, err
+		}
+		return bu.PrecFloat().Sub(one, complement), nil
+	}
+	return incompleteBetaContinuedFraction(x, a, b)
+}
+
+// I_x(a, b) = x^a * (1 - x)^b / (a * B(a, b)) * 1/(1 + d1/(1 + d2/(1 + ...))), where
+// d_(2m+1) = -(a + m)(a + b + m)x / ((a + 2m)(a + 2m + 1)) and d_(2m) = m(b - m)x / ((a + 2m - 1)(a + 2m))
+func incompleteBetaContinuedFraction(x, a, b *big.Float) (*big.Float, error) {
+	lnX, err := Ln(x)
+	if err != nil {
+		return nil, err
+	}
+	lnOneMinusX, err := Ln(bu.PrecFloat().Sub(bu.StrToFloat("1"), x))
+	if err != nil {
+		return nil, err
+	}
+	lnBetaAB, err := lnBeta(a, b)
+	if err != nil {
+		return nil, err
+	}
+	lnPrefactor := bu.PrecFloat().Add(bu.PrecFloat().Mul(a, lnX), bu.PrecFloat().Mul(b, lnOneMinusX))
+	lnPrefactor.Sub(lnPrefactor, lnBetaAB)
+	prefactor := Exp(lnPrefactor)
+
+	aPlusB := bu.PrecFloat().Add(a, b)
+	one := bu.StrToFloat("1")
+	fraction := continuedFraction(one, func(n int64) (dn, bn *big.Float) {
+		m := bu.PrecFloat().SetInt64(n / 2)
+		twoM := bu.PrecFloat().SetInt64(n - n%2)
+		if n%2 == 1 {
+			numerator := bu.PrecFloat().Mul(bu.PrecFloat().Add(a, m), bu.PrecFloat().Add(aPlusB, m))
+			numerator.Mul(numerator, x)
+			denominator := bu.PrecFloat().Add(a, twoM)
+			denominator.Mul(denominator, bu.PrecFloat().Add(denominator, one))
+			return bu.PrecFloat().Neg(numerator.Quo(numerator, denominator)), one
+		}
+		numerator := bu.PrecFloat().Mul(m, bu.PrecFloat().Sub(b, m))
+		numerator.Mul(numerator, x)
+		denominator := bu.PrecFloat().Add(a, twoM)
+		denominator.Mul(denominator, bu.PrecFloat().Sub(denominator, one))
+		return numerator.Quo(numerator, denominator), one
+	})
+	return bu.PrecFloat().Quo(prefactor, bu.PrecFloat().Mul(a, fraction)), nil
+}
+
+// ln B(a, b) = ln Γ(a) + ln Γ(b) - ln Γ(a + b)
+func lnBeta(a, b *big.Float) (*big.Float, error) {
+	lnGammaA, err := LogGamma(a)
+	if err != nil {
+		return nil, err
+	}
+	lnGammaB, err := LogGamma(b)
+	if err != nil {
+		return nil, err
+	}
+	lnGammaAB, err := LogGamma(bu.PrecFloat().Add(a, b))
+	if err != nil {
+		return nil, err
+	}
+	return bu.PrecFloat().Sub(bu.PrecFloat().Add(lnGammaA, lnGammaB), lnGammaAB), nil
+}
+
+// f(x) = x^(a - 1) * (1 - x)^(b - 1) / B(a, b)
+func betaDensity(x, a, b *big.Float) (big.Float, error) {
+	one := bu.StrToFloat("1")
+	if x.Sign() != 1 || x.Cmp(one) >= 0 {
+		return *bu.PrecFloat().SetInt64(0), nil
+	}
+	lnX, err := Ln(x)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnOneMinusX, err := Ln(bu.PrecFloat().Sub(one, x))
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnBetaAB, err := lnBeta(a, b)
+	if err != nil {
+		return big.Float{}, err
+	}
+	lnDensity := bu.PrecFloat().Mul(bu.PrecFloat().Sub(a, one), lnX)
+	lnDensity.Add(lnDensity, bu.PrecFloat().Mul(bu.PrecFloat().Sub(b, one), lnOneMinusX))
+	lnDensity.Sub(lnDensity, lnBetaAB)
+	return *Exp(lnDensity), nil
+}
+
+// betaQuantile solves I_x(a, b) = probability for x, starting from the mean a/(a + b)
+func betaQuantile(probability, a, b *big.Float) (quantile big.Float, err error) {
+	if a.Sign() != 1 || b.Sign() != 1 {
+		return big.Float{}, errors.New("beta quantile shapes (a, b) must be positive")
+	}
+	guess := bu.PrecFloat().Quo(a, bu.PrecFloat().Add(a, b))
+	cdf := func(x *big.Float) (big.Float, error) {
+		beta, err := RegularizedIncompleteBeta(x, a, b)
+		if err != nil {
+			return big.Float{}, err
+		}
+		return *beta, nil
+	}
+	pdf := func(x *big.Float) (big.Float, error) { return betaDensity(x, a, b) }
+	return invertCDF(probability, guess, bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(1), cdf, pdf)
+}
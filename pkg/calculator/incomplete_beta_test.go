@@ -0,0 +1,40 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_RegularizedIncompleteBeta(t *testing.T) {
+	tests := []struct {
+		name    string
+		x, a, b *big.Float
+		want    string
+		wantErr bool
+	}{
+		{name: "integer shapes", x: bu.StrToFloat("0.3"), a: bu.StrToFloat("2"), b: bu.StrToFloat("3"), want: "0.3483"},
+		{name: "symmetric arcsine at its median", x: bu.StrToFloat("0.5"), a: bu.StrToFloat("0.5"), b: bu.StrToFloat("0.5"), want: "0.5"},
+		{name: "uses the symmetry relation", x: bu.StrToFloat("0.9"), a: bu.StrToFloat("5"), b: bu.StrToFloat("0.5"), want: "0.3166429150200122558"},
+		{name: "tiny lower tail", x: bu.StrToFloat("0.01"), a: bu.StrToFloat("10"), b: bu.StrToFloat("20"), want: "0.0000000000001684131793287539"},
+		{name: "x of zero", x: bu.StrToFloat("0"), a: bu.StrToFloat("2"), b: bu.StrToFloat("3"), want: "0.0"},
+		{name: "x of one", x: bu.StrToFloat("1"), a: bu.StrToFloat("2"), b: bu.StrToFloat("3"), want: "1.0"},
+		{name: "x above one returns error", x: bu.StrToFloat("1.5"), a: bu.StrToFloat("2"), b: bu.StrToFloat("3"), wantErr: true},
+		{name: "non-positive shape returns error", x: bu.StrToFloat("0.5"), a: bu.StrToFloat("-2"), b: bu.StrToFloat("3"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RegularizedIncompleteBeta(tt.x, tt.a, tt.b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RegularizedIncompleteBeta() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("RegularizedIncompleteBeta() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_RegularizedLowerGamma(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, x    *big.Float
+		want    string
+		wantErr bool
+	}{
+		{name: "P(1, 1) is 1 - 1/e", a: bu.StrToFloat("1"), x: bu.StrToFloat("1"), want: "0.6321205588285576784"},
+		{name: "half integer shape", a: bu.StrToFloat("2.5"), x: bu.StrToFloat("1"), want: "0.1508549639153903638"},
+		{name: "continued fraction region", a: bu.StrToFloat("3"), x: bu.StrToFloat("5"), want: "0.8753479805169188587"},
+		{name: "small x", a: bu.StrToFloat("0.5"), x: bu.StrToFloat("0.02"), want: "0.1585194188782060461"},
+		{name: "x of zero", a: bu.StrToFloat("2"), x: bu.StrToFloat("0"), want: "0.0"},
+		{name: "non-positive shape returns error", a: bu.StrToFloat("0"), x: bu.StrToFloat("1"), wantErr: true},
+		{name: "negative x returns error", a: bu.StrToFloat("1"), x: bu.StrToFloat("-1"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RegularizedLowerGamma(tt.a, tt.x)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RegularizedLowerGamma() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("RegularizedLowerGamma() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_RegularizedUpperGamma(t *testing.T) {
+	tests := []struct {
+		name string
+		a, x *big.Float
+		want string
+	}{
+		{name: "Q(1, 1) is 1/e", a: bu.StrToFloat("1"), x: bu.StrToFloat("1"), want: "0.3678794411714423216"},
+		{name: "far upper tail keeps its precision", a: bu.StrToFloat("10"), x: bu.StrToFloat("30"), want: "0.0000071217508628"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RegularizedUpperGamma(tt.a, tt.x)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("RegularizedUpperGamma() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+type densityFunction func(x *big.Float) (big.Float, error)
+
+// invertCDF solves cdf(x) = probability with Newton's method, falling back to bisection whenever a Newton step would
+// leave the current bracket. lowerBound and upperBound are the ends of the support, or nil where it is unbounded.
+// The guess only needs to be in the right neighbourhood; the bracket is widened from it until it contains the root
+func invertCDF(probability *big.Float, guess *big.Float, lowerBound, upperBound *big.Float, cdf, pdf densityFunction) (quantile big.Float, err error) {
+	if probability.Sign() != 1 || probability.Cmp(bu.StrToFloat("1")) >= 0 {
+		return big.Float{}, errors.New("quantile probability must be strictly between 0 and 1")
+	}
+	two := bu.StrToFloat("2")
+	below := func(x *big.Float) (bool, error) {
+		cumulative, err := cdf(x)
+		if err != nil {
+			return false, err
+		}
+		return cumulative.Cmp(probability) < 0, nil
+	}
+
+	lo, hi := bu.PrecFloat().Copy(guess), bu.PrecFloat().Copy(guess)
+	width := bu.PrecFloat().Abs(guess)
+	if width.Sign() == 0 {
+		width.SetInt64(1)
+	}
+	for {
+		isBelow, err := below(hi)
+		if err != nil {
+			return big.Float{}, err
+		}
+		if isBelow {
+			lo.Set(hi)
+			if upperBound != nil {
+				hi.Add(upperBound, bu.PrecFloat().Quo(bu.PrecFloat().Sub(hi, upperBound), two))
+			} else {
+				hi.Add(hi, width)
+				width.Mul(width, two)
+			}
+			continue
+		}
+		break
+	}
+	for {
+		isBelow, err := below(lo)
+		if err != nil {
+			return big.Float{}, err
+		}
+		if isBelow {
+			break
+		}
+		hi.Set(lo)
+		if lowerBound != nil {
+			// Halve the distance to the bound so the bracket never leaves the support
+			lo.Add(lowerBound, bu.PrecFloat().Quo(bu.PrecFloat().Sub(lo, lowerBound), two))
+		} else {
+			lo.Sub(lo, width)
+			width.Mul(width, two)
+		}
+	}
+
+	current := bu.PrecFloat().Quo(bu.PrecFloat().Add(lo, hi), two)
+	if guess.Cmp(lo) > 0 && guess.Cmp(hi) < 0 {
+		current.Set(guess)
+	}
+	for range 2000 {
+		cumulative, err := cdf(current)
+		if err != nil {
+			return big.Float{}, err
+		}
+		difference := bu.PrecFloat().Sub(&cumulative, probability)
+		if difference.Sign() == 0 {
+			break
+		}
+		if difference.Sign() < 0 {
+			lo.Set(current)
+		} else {
+			hi.Set(current)
+		}
+		density, err := pdf(current)
+		if err != nil {
+			return big.Float{}, err
+		}
+		next := bu.PrecFloat().Quo(bu.PrecFloat().Add(lo, hi), two)
+		if density.Sign() == 1 {
+			newton := bu.PrecFloat().Sub(current, bu.PrecFloat().Quo(difference, &density))
+			if newton.Cmp(lo) > 0 && newton.Cmp(hi) < 0 {
+				next = newton
+			}
+		}
+		step := bu.PrecFloat().Sub(next, current)
+		current = next
+		if isConverged(step, current) || isConverged(bu.PrecFloat().Sub(hi, lo), current) {
+			break
+		}
+	}
+	return *current, nil
+}
@@ -0,0 +1,185 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// Past this many possible outcomes MultinomialTest stops enumerating and falls back to Pearson's chi-square
+const multinomialEnumerationLimit int64 = 200000
+
+// Probabilities usually arrive as float64 decimals like 0.1, whose binary values only add up to 1 to within about
+// 1e-16, so a sum this close to 1 is accepted as 1
+const probabilitySumTolerance = "1e-12"
+
+// MultinomialTestResult holds the p-value of an exact multinomial goodness-of-fit test. When enumerating every outcome
+// would exceed the enumeration limit, Exact is false and PValue comes from the chi-square approximation instead.
+// Statistic and DegreesOfFreedom are Pearson's X^2 and k - 1 either way
+type MultinomialTestResult struct {
+	PValue           big.Float
+	Exact            bool
+	Outcomes         int64
+	Statistic        big.Float
+	DegreesOfFreedom int64
+}
+
+func validateMultinomial(counts []int64, probabilities []*big.Float) error {
+	if len(counts) < 2 {
+		return errors.New("multinomial counts must have at least two categories")
+	}
+	if len(counts) != len(probabilities) {
+		return errors.New("multinomial counts and probabilities must have the same length")
+	}
+	one := bu.StrToFloat("1")
+	for i, p := range probabilities {
+		if counts[i] < 0 {
+			return errors.New("multinomial counts cannot be negative")
+		}
+		if p == nil || p.Sign() == -1 || p.Cmp(one) > 0 {
+			return errors.New("multinomial probabilities must be between 0 and 1")
+		}
+	}
+	if !sumsToOne(probabilities) {
+		return errors.New("multinomial probabilities must sum to 1")
+	}
+	return nil
+}
+
+// sumsToOne reports whether the probabilities add up to 1 within probabilitySumTolerance
+func sumsToOne(probabilities []*big.Float) bool {
+	sum := bu.PrecFloat().SetInt64(0)
+	for _, p := range probabilities {
+		sum.Add(sum, p)
+	}
+	difference := sum.Sub(sum, bu.StrToFloat("1"))
+	return difference.Abs(difference).Cmp(bu.StrToFloat(probabilitySumTolerance)) <= 0
+}
+
+// n! / (k_1! k_2! ... k_m!)
+func multinomialCoefficient(counts []int64) (*big.Int, error) {
+	total := int64(0)
+	for _, count := range counts {
+		total += count
+	}
+	coefficient, err := Factorial(total)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		factorial, err := Factorial(count)
+		if err != nil {
+			return nil, err
+		}
+		coefficient.Quo(coefficient, factorial)
+	}
+	return coefficient, nil
+}
+
+// P(X = counts) = n! / (k_1! ... k_m!) * p_1^k_1 * ... * p_m^k_m
+func MultinomialProbability(counts []int64, probabilities []*big.Float) (probability big.Float, err error) {
+	if err := validateMultinomial(counts, probabilities); err != nil {
+		return big.Float{}, err
+	}
+	coefficient, err := multinomialCoefficient(counts)
+	if err != nil {
+		return big.Float{}, err
+	}
+	result := bu.PrecFloat().SetInt(coefficient)
+	for i, count := range counts {
+		result.Mul(result, IntPow(probabilities[i], big.NewInt(count)))
+	}
+	return *result, nil
+}
+
+// MultinomialTest sums the probability of every outcome with the same total that is no more likely than the observed
+// counts, using the same tolerance for ties as the minimum-likelihood binomial test
+func MultinomialTest(counts []int64, probabilities []*big.Float) (result MultinomialTestResult, err error) {
+	if err := validateMultinomial(counts, probabilities); err != nil {
+		return MultinomialTestResult{}, err
+	}
+	for _, p := range probabilities {
+		if p.Sign() == 0 {
+			return MultinomialTestResult{}, errors.New("multinomial test probabilities must be positive")
+		}
+	}
+	total := int64(0)
+	for _, count := range counts {
+		total += count
+	}
+	if total == 0 {
+		return MultinomialTestResult{}, errors.New("multinomial test needs at least one observation")
+	}
+	result.DegreesOfFreedom = int64(len(counts) - 1)
+	result.Statistic = *pearsonStatistic(counts, probabilities, total)
+
+	// There are C(n + m - 1, m - 1) ways to split n observations across m categories
+	outcomes, err := calculateBinomialCoefficient(total+result.DegreesOfFreedom, result.DegreesOfFreedom)
+	if err != nil {
+		return MultinomialTestResult{}, err
+	}
+	if !outcomes.IsInt64() || outcomes.Int64() > multinomialEnumerationLimit {
+		pValue, err := ChiSquarePValue(&result.Statistic, bu.PrecFloat().SetInt64(result.DegreesOfFreedom), "right")
+		if err != nil {
+			return MultinomialTestResult{}, err
+		}
+		result.PValue = pValue
+		return result, nil
+	}
+	result.Exact, result.Outcomes = true, outcomes.Int64()
+
+	// Every outcome's probability is n! * Π p_i^x_i / x_i!, so tabulate p_i^x / x! once per category
+	factors := make([][]*big.Float, len(counts))
+	for i, p := range probabilities {
+		factors[i] = make([]*big.Float, total+1)
+		factors[i][0] = bu.PrecFloat().SetInt64(1)
+		for x := int64(1); x <= total; x++ {
+			factors[i][x] = bu.PrecFloat().Mul(factors[i][x-1], bu.PrecFloat().Quo(p, bu.PrecFloat().SetInt64(x)))
+		}
+	}
+	observed := bu.PrecFloat().SetInt64(1)
+	for i, count := range counts {
+		observed.Mul(observed, factors[i][count])
+	}
+	threshold := bu.PrecFloat().Mul(observed, bu.StrToFloat(minLikelihoodRelativeError))
+
+	sum := bu.PrecFloat().SetInt64(0)
+	var enumerate func(category int, remaining int64, partial *big.Float)
+	enumerate = func(category int, remaining int64, partial *big.Float) {
+		if category == len(counts)-1 {
+			outcome := bu.PrecFloat().Mul(partial, factors[category][remaining])
+			if outcome.Cmp(threshold) <= 0 {
+				sum.Add(sum, outcome)
+			}
+			return
+		}
+		for x := int64(0); x <= remaining; x++ {
+			enumerate(category+1, remaining-x, bu.PrecFloat().Mul(partial, factors[category][x]))
+		}
+	}
+	enumerate(0, total, bu.PrecFloat().SetInt64(1))
+
+	factorial, err := Factorial(total)
+	if err != nil {
+		return MultinomialTestResult{}, err
+	}
+	sum.Mul(sum, bu.PrecFloat().SetInt(factorial))
+	if sum.Cmp(bu.StrToFloat("1")) > 0 {
+		sum.SetInt64(1)
+	}
+	result.PValue = *sum
+	return result, nil
+}
+
+// X^2 = Σ (observed - expected)^2 / expected, with expected = n * p_i
+func pearsonStatistic(counts []int64, probabilities []*big.Float, total int64) *big.Float {
+	statistic := bu.PrecFloat().SetInt64(0)
+	n := bu.PrecFloat().SetInt64(total)
+	for i, count := range counts {
+		expected := bu.PrecFloat().Mul(n, probabilities[i])
+		difference := bu.PrecFloat().Sub(bu.PrecFloat().SetInt64(count), expected)
+		statistic.Add(statistic, bu.PrecFloat().Quo(bu.PrecFloat().Mul(difference, difference), expected))
+	}
+	return statistic
+}
@@ -0,0 +1,83 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_MultinomialProbability(t *testing.T) {
+	proportions := []*big.Float{bu.StrToFloat("0.2"), bu.StrToFloat("0.3"), bu.StrToFloat("0.5")}
+	tests := []struct {
+		name          string
+		counts        []int64
+		probabilities []*big.Float
+		want          string
+		wantErr       bool
+	}{
+		{name: "It should return the probability of the counts", counts: []int64{2, 3, 5}, probabilities: proportions, want: "0.08505"},
+		{name: "It should reduce to the binomial with two categories", counts: []int64{3, 7}, probabilities: []*big.Float{bu.StrToFloat("0.5"), bu.StrToFloat("0.5")}, want: "0.1171875"},
+		{name: "It should return 0 for a count in an impossible category", counts: []int64{1, 2}, probabilities: []*big.Float{bu.StrToFloat("0"), bu.StrToFloat("1")}, want: "0.0"},
+		{name: "It should accept float64 probabilities that only sum to 1 in decimal", counts: []int64{1, 2, 3}, probabilities: []*big.Float{big.NewFloat(0.1), big.NewFloat(0.2), big.NewFloat(0.7)}, want: "0.08232"},
+		{name: "It should reject mismatched lengths", counts: []int64{1, 2}, probabilities: proportions, wantErr: true},
+		{name: "It should reject probabilities that do not sum to 1", counts: []int64{1, 2}, probabilities: []*big.Float{bu.StrToFloat("0.5"), bu.StrToFloat("0.6")}, wantErr: true},
+		{name: "It should reject a negative count", counts: []int64{-1, 2, 3}, probabilities: proportions, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MultinomialProbability(tt.counts, tt.probabilities)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MultinomialProbability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("MultinomialProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_MultinomialTest(t *testing.T) {
+	proportions := []*big.Float{bu.StrToFloat("0.2"), bu.StrToFloat("0.3"), bu.StrToFloat("0.5")}
+	tests := []struct {
+		name          string
+		counts        []int64
+		probabilities []*big.Float
+		want          string
+		wantExact     bool
+		wantStatistic string
+		wantErr       bool
+	}{
+		{name: "It should enumerate a small sample", counts: []int64{5, 1, 4}, probabilities: proportions, want: "0.0699808375", wantExact: true, wantStatistic: "6.03333333333333333333"},
+		{name: "It should enumerate a moderate sample", counts: []int64{50, 80, 170}, probabilities: proportions, want: "0.070546222703711561259", wantExact: true, wantStatistic: "5.44444444444444444444"},
+		{name: "It should fall back to chi-square for a large sample", counts: []int64{500, 800, 1700}, probabilities: proportions, want: "0.0000000000015050090232628073", wantExact: false, wantStatistic: "54.4444444444444444444"},
+		{name: "It should reject a zero probability", counts: []int64{1, 2}, probabilities: []*big.Float{bu.StrToFloat("0"), bu.StrToFloat("1")}, wantErr: true},
+		{name: "It should reject an empty sample", counts: []int64{0, 0, 0}, probabilities: proportions, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MultinomialTest(tt.counts, tt.probabilities)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MultinomialTest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Exact != tt.wantExact {
+				t.Errorf("MultinomialTest() exact = %v, want %v", got.Exact, tt.wantExact)
+			}
+			if got.DegreesOfFreedom != int64(len(tt.counts)-1) {
+				t.Errorf("MultinomialTest() degrees of freedom = %d, want %d", got.DegreesOfFreedom, len(tt.counts)-1)
+			}
+			if compare := bu.NewCompare(&got.Statistic, tt.wantStatistic); !compare.Equal() {
+				t.Errorf("MultinomialTest() statistic = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(&got.PValue, tt.want); !compare.Equal() {
+				t.Errorf("MultinomialTest() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+package calculator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// NegativeBinomialParameterisation selects what the negative binomial variable counts on the way to the r-th success
+type NegativeBinomialParameterisation string
+
+const (
+	// X is the number of failures before the r-th success, so X = 0, 1, 2, ...
+	NegativeBinomialFailures NegativeBinomialParameterisation = "failures"
+	// X is the number of trials up to and including the r-th success, so X = r, r + 1, r + 2, ...
+	NegativeBinomialTrials NegativeBinomialParameterisation = "trials"
+)
+
+// Above this many failures, tails come from the incomplete beta function rather than summing every term
+const negativeBinomialSummationLimit int64 = 10000
+
+// negativeBinomialFailures validates the inputs and converts x to the number of failures before the r-th success.
+// Like a negative failure count, a trial count below r is outside the support and rejected
+func negativeBinomialFailures(p *big.Float, successes, x int64, parameterisation NegativeBinomialParameterisation) (int64, error) {
+	if p.Sign() != 1 || p.Cmp(bu.StrToFloat("1")) > 0 {
+		return 0, errors.New("negative binomial chance of success (p) must be greater than 0 and at most 1")
+	}
+	if successes <= 0 {
+		return 0, errors.New("negative binomial successes (r) must be positive")
+	}
+	switch parameterisation {
+	case NegativeBinomialFailures:
+		if x < 0 {
+			return 0, errors.New("negative binomial failures (k) cannot be negative")
+		}
+		return x, nil
+	case NegativeBinomialTrials:
+		if x < successes {
+			return 0, errors.New("negative binomial trials (x) cannot be less than successes (r)")
+		}
+		return x - successes, nil
+	}
+	return 0, fmt.Errorf("negative binomial parameterisation must be %q or %q, got %q", NegativeBinomialFailures, NegativeBinomialTrials, parameterisation)
+}
+
+// P(K = k) = C(k + r - 1, k) * p^r * (1 - p)^k for k failures before the r-th success
+func NegativeBinomialProbability(p *big.Float, successes, x int64, parameterisation NegativeBinomialParameterisation) (probability big.Float, err error) {
+	failures, err := negativeBinomialFailures(p, successes, x, parameterisation)
+	if err != nil {
+		return big.Float{}, err
+	}
+	term, err := negativeBinomialTerm(p, successes, failures)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *term, nil
+}
+
+// CumulativeNegativeBinomialProbability returns P(X <= x) and each term of the sum. terms[0] is the first value of the
+// support, which is 0 failures or r trials depending on the parameterisation
+func CumulativeNegativeBinomialProbability(p *big.Float, successes, x int64, parameterisation NegativeBinomialParameterisation) (cumulative big.Float, terms []big.Float, err error) {
+	failures, err := negativeBinomialFailures(p, successes, x, parameterisation)
+	if err != nil {
+		return big.Float{}, nil, err
+	}
+	acc := bu.PrecFloat().SetInt64(0)
+	terms = make([]big.Float, 0, failures+1)
+	// P(K = 0) = p^r, and P(K = i + 1) = P(K = i) * (i + r)/(i + 1) * (1 - p)
+	oneMinusP := bu.PrecFloat().Sub(bu.StrToFloat("1"), p)
+	term := IntPow(p, big.NewInt(successes))
+	for i := int64(0); i <= failures; i++ {
+		acc.Add(acc, term)
+		terms = append(terms, *bu.PrecFloat().Copy(term))
+		ratio := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(i+successes), bu.PrecFloat().SetInt64(i+1))
+		term = bu.PrecFloat().Mul(term, bu.PrecFloat().Mul(ratio, oneMinusP))
+	}
+	return *acc, terms, nil
+}
+
+func NegativeBinomialPValue(p *big.Float, successes, x int64, parameterisation NegativeBinomialParameterisation, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	failures, err := negativeBinomialFailures(p, successes, x, parameterisation)
+	if err != nil {
+		return big.Float{}, err
+	}
+	left, right, err := negativeBinomialTails(p, successes, failures)
+	if err != nil {
+		return big.Float{}, err
+	}
+	switch tail {
+	case "left":
+		return *left, nil
+	case "right":
+		return *right, nil
+	}
+	return doubleSmallerTail(left, right), nil
+}
+
+// negativeBinomialTails returns P(K <= k) = I_p(r, k + 1) and P(K >= k) = I_(1-p)(k, r)
+func negativeBinomialTails(p *big.Float, successes, failures int64) (left *big.Float, right *big.Float, err error) {
+	if failures <= negativeBinomialSummationLimit {
+		leftCum, terms, err := CumulativeNegativeBinomialProbability(p, successes, failures, NegativeBinomialFailures)
+		if err != nil {
+			return nil, nil, err
+		}
+		right = bu.PrecFloat().Sub(bu.StrToFloat("1"), &leftCum)
+		return &leftCum, right.Add(right, &terms[failures]), nil
+	}
+	one := bu.StrToFloat("1")
+	r := bu.PrecFloat().SetInt64(successes)
+	left, err = RegularizedIncompleteBeta(p, r, bu.PrecFloat().SetInt64(failures+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err = RegularizedIncompleteBeta(bu.PrecFloat().Sub(one, p), bu.PrecFloat().SetInt64(failures), r)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+// NegativeBinomialQuantile returns the smallest x with P(X <= x) >= alpha, along with P(X <= x) itself. The support is
+// unbounded, so alpha must be below 1
+func NegativeBinomialQuantile(p *big.Float, successes int64, alpha *big.Float, parameterisation NegativeBinomialParameterisation) (x int64, cumulative big.Float, err error) {
+	if _, err := negativeBinomialFailures(p, successes, successes, parameterisation); err != nil {
+		return 0, big.Float{}, err
+	}
+	if alpha.Sign() == -1 || alpha.Cmp(bu.StrToFloat("1")) >= 0 {
+		return 0, big.Float{}, errors.New("negative binomial quantile target probability (alpha) must be at least 0 and below 1")
+	}
+	offset := int64(0)
+	if parameterisation == NegativeBinomialTrials {
+		offset = successes
+	}
+	if p.Cmp(bu.StrToFloat("1")) == 0 {
+		return offset, *bu.PrecFloat().SetInt64(1), nil
+	}
+
+	// Start from the normal approximation with mean r(1 - p)/p and variance r(1 - p)/p^2, then step one term at a time
+	pFloat, _ := p.Float64()
+	alphaFloat, _ := alpha.Float64()
+	mean := float64(successes) * (1 - pFloat) / pFloat
+	z := -math.Sqrt2 * math.Erfcinv(2*alphaFloat)
+	guess := math.Floor(mean + z*math.Sqrt(mean/pFloat))
+	if guess >= math.MaxInt64 {
+		return 0, big.Float{}, errors.New("negative binomial quantile exceeds int64")
+	}
+	failures := int64(0)
+	if !math.IsNaN(guess) && guess > 0 {
+		failures = int64(guess)
+	}
+	left, _, err := negativeBinomialTails(p, successes, failures)
+	if err != nil {
+		return 0, big.Float{}, err
+	}
+	current := *left
+	term, err := negativeBinomialTerm(p, successes, failures)
+	if err != nil {
+		return 0, big.Float{}, err
+	}
+	oneMinusP := bu.PrecFloat().Sub(bu.StrToFloat("1"), p)
+	for current.Cmp(alpha) < 0 {
+		term.Mul(term, bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(failures+successes), bu.PrecFloat().SetInt64(failures+1)))
+		term.Mul(term, oneMinusP)
+		failures++
+		current.Add(&current, term)
+	}
+	for failures > 0 {
+		below := bu.PrecFloat().Sub(&current, term)
+		if below.Cmp(alpha) < 0 {
+			break
+		}
+		current = *below
+		term.Mul(term, bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(failures), bu.PrecFloat().SetInt64(failures+successes-1)))
+		term.Quo(term, oneMinusP)
+		failures--
+	}
+	return failures + offset, current, nil
+}
+
+// negativeBinomialTerm returns P(K = k), going through LogBinomialCoefficient once the coefficient would get large
+func negativeBinomialTerm(p *big.Float, successes, failures int64) (*big.Float, error) {
+	oneMinusP := bu.PrecFloat().Sub(bu.StrToFloat("1"), p)
+	if failures+successes <= negativeBinomialSummationLimit || oneMinusP.Sign() == 0 {
+		coefficient, err := calculateBinomialCoefficient(failures+successes-1, failures)
+		if err != nil {
+			return nil, err
+		}
+		term := bu.PrecFloat().SetInt(coefficient)
+		term.Mul(term, IntPow(p, big.NewInt(successes)))
+		return term.Mul(term, IntPow(oneMinusP, big.NewInt(failures))), nil
+	}
+	logCoefficient, err := LogBinomialCoefficient(bu.PrecFloat().SetInt64(failures+successes-1), bu.PrecFloat().SetInt64(failures))
+	if err != nil {
+		return nil, err
+	}
+	lnP, err := Ln(p)
+	if err != nil {
+		return nil, err
+	}
+	lnQ, err := Ln(oneMinusP)
+	if err != nil {
+		return nil, err
+	}
+	logTerm := bu.PrecFloat().Add(logCoefficient, bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(successes), lnP))
+	logTerm.Add(logTerm, bu.PrecFloat().Mul(bu.PrecFloat().SetInt64(failures), lnQ))
+	return Exp(logTerm), nil
+}
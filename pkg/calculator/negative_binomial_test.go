@@ -0,0 +1,140 @@
+package calculator
+
+import (
+	"strings"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_NegativeBinomialProbability(t *testing.T) {
+	tests := []struct {
+		name             string
+		successes, x     int64
+		parameterisation NegativeBinomialParameterisation
+		want             string
+		wantErr          bool
+	}{
+		{name: "It should count failures before the r-th success", successes: 3, x: 5, parameterisation: NegativeBinomialFailures, want: "0.10450944"},
+		{name: "It should count trials up to the r-th success", successes: 3, x: 8, parameterisation: NegativeBinomialTrials, want: "0.10450944"},
+		{name: "It should reject fewer trials than successes", successes: 3, x: 2, parameterisation: NegativeBinomialTrials, wantErr: true},
+		{name: "It should reject negative failures", successes: 3, x: -1, parameterisation: NegativeBinomialFailures, wantErr: true},
+		{name: "It should reject r of 0", successes: 0, x: 5, parameterisation: NegativeBinomialFailures, wantErr: true},
+		{name: "It should reject an unknown parameterisation", successes: 3, x: 5, parameterisation: "successes", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NegativeBinomialProbability(bu.StrToFloat("0.4"), tt.successes, tt.x, tt.parameterisation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NegativeBinomialProbability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NegativeBinomialProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_CumulativeNegativeBinomialProbability(t *testing.T) {
+	for _, parameterisation := range []NegativeBinomialParameterisation{NegativeBinomialFailures, NegativeBinomialTrials} {
+		x := int64(5)
+		if parameterisation == NegativeBinomialTrials {
+			x += 3
+		}
+		cumulative, terms, err := CumulativeNegativeBinomialProbability(bu.StrToFloat("0.4"), 3, x, parameterisation)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(terms) != 6 {
+			t.Errorf("%s: expected 6 terms, got %d", parameterisation, len(terms))
+		}
+		if compare := bu.NewCompare(&terms[0], "0.064"); !compare.Equal() {
+			t.Errorf("%s: terms[0] = %v, want %v", parameterisation, compare.ActualAsString, compare.Expected)
+		}
+		if compare := bu.NewCompare(&cumulative, "0.68460544"); !compare.Equal() {
+			t.Errorf("%s: CumulativeNegativeBinomialProbability() = %v, want %v", parameterisation, compare.ActualAsString, compare.Expected)
+		}
+	}
+	if _, _, err := CumulativeNegativeBinomialProbability(bu.StrToFloat("0.4"), 3, 2, NegativeBinomialTrials); err == nil {
+		t.Errorf("CumulativeNegativeBinomialProbability() expected an error for fewer trials than successes")
+	}
+}
+
+func Test_NegativeBinomialPValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		successes int64
+		p         string
+		x         int64
+		tail      string
+		want      string
+		wantErr   bool
+	}{
+		{name: "left tail", successes: 3, p: "0.4", x: 10, tail: "left", want: "0.9420975898624"},
+		{name: "right tail", successes: 3, p: "0.4", x: 10, tail: "right", want: "0.08344332288"},
+		{name: "two tails", successes: 3, p: "0.4", x: 10, tail: "two", want: "0.16688664576"},
+		{name: "left tail beyond the summation limit", successes: 5, p: "0.001", x: 20000, tail: "left", want: "0.9999832330718"},
+		{name: "right tail beyond the summation limit", successes: 5, p: "0.001", x: 20000, tail: "right", want: "0.0000167805391690"},
+		{name: "invalid tail returns error", successes: 3, p: "0.4", x: 10, tail: "middle", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NegativeBinomialPValue(bu.StrToFloat(tt.p), tt.successes, tt.x, NegativeBinomialFailures, tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NegativeBinomialPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NegativeBinomialPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_NegativeBinomialQuantile(t *testing.T) {
+	tests := []struct {
+		name             string
+		p                string
+		successes        int64
+		alpha            string
+		parameterisation NegativeBinomialParameterisation
+		wantX            int64
+		wantCumul        string
+		wantErr          bool
+	}{
+		{name: "It should find the median number of failures", p: "0.4", successes: 3, alpha: "0.5", parameterisation: NegativeBinomialFailures, wantX: 4, wantCumul: "0.580096"},
+		{name: "It should find an upper threshold", p: "0.4", successes: 3, alpha: "0.95", parameterisation: NegativeBinomialFailures, wantX: 11, wantCumul: "0.96020841889792"},
+		{name: "It should return the start of the support for alpha of 0", p: "0.4", successes: 3, alpha: "0", parameterisation: NegativeBinomialTrials, wantX: 3, wantCumul: "0.064"},
+		{name: "It should handle a rare success", p: "0.0001", successes: 50, alpha: "0.9", parameterisation: NegativeBinomialTrials, wantX: 592485, wantCumul: "0.9000002340"},
+		{name: "It should reject alpha of 1", p: "0.4", successes: 3, alpha: "1", parameterisation: NegativeBinomialFailures, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, cumulative, err := NegativeBinomialQuantile(bu.StrToFloat(tt.p), tt.successes, bu.StrToFloat(tt.alpha), tt.parameterisation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NegativeBinomialQuantile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if x != tt.wantX {
+				t.Errorf("NegativeBinomialQuantile() x = %d, want %d", x, tt.wantX)
+			}
+			if compare := bu.NewCompare(&cumulative, tt.wantCumul); !compare.Equal() {
+				t.Errorf("NegativeBinomialQuantile() cumulative = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_NegativeBinomialQuantileOverflow(t *testing.T) {
+	_, _, err := NegativeBinomialQuantile(bu.StrToFloat("1e-30"), 1, bu.StrToFloat("0.5"), NegativeBinomialFailures)
+	if err == nil || !strings.Contains(err.Error(), "exceeds int64") {
+		t.Errorf("NegativeBinomialQuantile() error = %v, want the int64 overflow error", err)
+	}
+}
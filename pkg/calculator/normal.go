@@ -0,0 +1,123 @@
+package calculator
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func ZScore(x, mean, stdDev *big.Float) (z big.Float, err error) {
+	if stdDev.Sign() != 1 {
+		return big.Float{}, errors.New("z-score standard deviation (sigma) must be positive")
+	}
+	return *bu.PrecFloat().Quo(bu.PrecFloat().Sub(x, mean), stdDev), nil
+}
+
+func NormalPDF(x, mean, stdDev *big.Float) (density big.Float, err error) {
+	z, err := ZScore(x, mean, stdDev)
+	if err != nil {
+		return big.Float{}, err
+	}
+	// f(x) = e^(-z^2/2) / (sigma * sqrt(2 * pi))
+	halfZSquared := bu.PrecFloat().Quo(bu.PrecFloat().Mul(&z, &z), bu.StrToFloat("2"))
+	numerator := Exp(bu.PrecFloat().Neg(halfZSquared))
+	sqrtTwoPi := bu.PrecFloat().Sqrt(bu.PrecFloat().Mul(bu.StrToFloat("2"), Pi()))
+	return *bu.PrecFloat().Quo(numerator, bu.PrecFloat().Mul(stdDev, sqrtTwoPi)), nil
+}
+
+func NormalCDF(x, mean, stdDev *big.Float) (cumulative big.Float, err error) {
+	z, err := ZScore(x, mean, stdDev)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return standardNormalCDF(&z), nil
+}
+
+// Phi(z) = erfc(-z/sqrt(2))/2. Going through erfc rather than (1 + erf)/2 keeps full relative precision in the
+// lower tail
+func standardNormalCDF(z *big.Float) big.Float {
+	scaled := bu.PrecFloat().Quo(bu.PrecFloat().Neg(z), bu.PrecFloat().Sqrt(bu.StrToFloat("2")))
+	return *bu.PrecFloat().Quo(Erfc(scaled), bu.StrToFloat("2"))
+}
+
+func NormalPValue(x, mean, stdDev *big.Float, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	z, err := ZScore(x, mean, stdDev)
+	if err != nil {
+		return big.Float{}, err
+	}
+	left := standardNormalCDF(&z)
+	right := standardNormalCDF(bu.PrecFloat().Neg(&z))
+	switch tail {
+	case "left":
+		return left, nil
+	case "right":
+		return right, nil
+	}
+	return doubleSmallerTail(&left, &right), nil
+}
+
+func NormalQuantile(probability, mean, stdDev *big.Float) (quantile big.Float, err error) {
+	if stdDev.Sign() != 1 {
+		return big.Float{}, errors.New("normal quantile standard deviation (sigma) must be positive")
+	}
+	if probability.Sign() != 1 || probability.Cmp(bu.StrToFloat("1")) >= 0 {
+		return big.Float{}, errors.New("normal quantile probability must be strictly between 0 and 1")
+	}
+	z, err := standardNormalQuantile(probability)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return *bu.PrecFloat().Add(mean, bu.PrecFloat().Mul(&z, stdDev)), nil
+}
+
+// Solve Phi(z) = p by Newton's method, z -= (Phi(z) - p) / phi(z). The float64 inverse error function gets us within
+// ~16 digits, so only a handful of big.Float steps are needed
+func standardNormalQuantile(probability *big.Float) (z big.Float, err error) {
+	half := bu.StrToFloat("0.5")
+	if probability.Cmp(half) > 0 {
+		// Solve in the lower tail and reflect
+		upper := bu.PrecFloat().Sub(bu.StrToFloat("1"), probability)
+		lower, err := standardNormalQuantile(upper)
+		if err != nil {
+			return big.Float{}, err
+		}
+		return *bu.PrecFloat().Neg(&lower), nil
+	}
+	p, _ := probability.Float64()
+	var guess float64
+	if p > 0 {
+		guess = -math.Sqrt2 * math.Erfcinv(2*p)
+	} else {
+		// Below float64's range, so use the tail asymptote Phi(z) ~ phi(z)/|z| instead, which rearranges to
+		// z^2 = -2 ln p - 2 ln|z| - ln(2 pi), and iterate it a few times from z = -sqrt(-2 ln p)
+		lnP, err := Ln(probability)
+		if err != nil {
+			return big.Float{}, err
+		}
+		f, _ := lnP.Float64()
+		guess = -math.Sqrt(-2 * f)
+		for range 4 {
+			guess = -math.Sqrt(-2*f - 2*math.Log(-guess) - math.Log(2*math.Pi))
+		}
+	}
+	current := bu.PrecFloat().SetFloat64(guess)
+	zero, one := bu.StrToFloat("0"), bu.StrToFloat("1")
+	for range 100 {
+		cumulative := standardNormalCDF(current)
+		density, err := NormalPDF(current, zero, one)
+		if err != nil {
+			return big.Float{}, err
+		}
+		step := bu.PrecFloat().Quo(bu.PrecFloat().Sub(&cumulative, probability), &density)
+		current.Sub(current, step)
+		if isConverged(step, current) {
+			break
+		}
+	}
+	return *current, nil
+}
@@ -0,0 +1,207 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_ZScore(t *testing.T) {
+	z, err := ZScore(bu.StrToFloat("130"), bu.StrToFloat("100"), bu.StrToFloat("15"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&z, "2.0"); !compare.Equal() {
+		t.Errorf("ZScore() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, err := ZScore(bu.StrToFloat("1"), bu.StrToFloat("0"), bu.StrToFloat("0")); err == nil {
+		t.Error("expected error for zero standard deviation")
+	}
+}
+
+func Test_NormalPDF(t *testing.T) {
+	tests := []struct {
+		name            string
+		x, mean, stdDev *big.Float
+		want            string
+		wantErr         bool
+	}{
+		{
+			name: "standard normal at 0",
+			x:    bu.StrToFloat("0"), mean: bu.StrToFloat("0"), stdDev: bu.StrToFloat("1"),
+			want: "0.398942280401432678",
+		},
+		{
+			name: "N(100, 15) at one standard deviation",
+			x:    bu.StrToFloat("115"), mean: bu.StrToFloat("100"), stdDev: bu.StrToFloat("15"),
+			want: "0.016131381634610",
+		},
+		{
+			name: "negative standard deviation returns error",
+			x:    bu.StrToFloat("0"), mean: bu.StrToFloat("0"), stdDev: bu.StrToFloat("-1"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalPDF(tt.x, tt.mean, tt.stdDev)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalPDF() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NormalPDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_NormalCDF(t *testing.T) {
+	zero, one := bu.StrToFloat("0"), bu.StrToFloat("1")
+	tests := []struct {
+		name            string
+		x, mean, stdDev *big.Float
+		want            string
+		wantErr         bool
+	}{
+		{
+			name: "standard normal at 0",
+			x:    zero, mean: zero, stdDev: one,
+			want: "0.5",
+		},
+		{
+			name: "standard normal at 1.96",
+			x:    bu.StrToFloat("1.96"), mean: zero, stdDev: one,
+			want: "0.97500210485177956586",
+		},
+		{
+			name: "lower tail keeps relative precision",
+			x:    bu.StrToFloat("-3"), mean: zero, stdDev: one,
+			want: "0.00134989803163009453",
+		},
+		{
+			name: "N(100, 15) at two standard deviations",
+			x:    bu.StrToFloat("130"), mean: bu.StrToFloat("100"), stdDev: bu.StrToFloat("15"),
+			want: "0.977249868051821",
+		},
+		{
+			name: "zero standard deviation returns error",
+			x:    zero, mean: zero, stdDev: zero,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalCDF(tt.x, tt.mean, tt.stdDev)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalCDF() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NormalCDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_NormalPValue(t *testing.T) {
+	zero, one := bu.StrToFloat("0"), bu.StrToFloat("1")
+	tests := []struct {
+		name    string
+		x       *big.Float
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{"left tail at 1.96", bu.StrToFloat("1.96"), "left", "0.975002104851780", false},
+		{"right tail at 1.96", bu.StrToFloat("1.96"), "right", "0.024997895148220", false},
+		{"two-tailed at 1.96", bu.StrToFloat("1.96"), "two", "0.049995790296441", false},
+		{"two-tailed at -1.96", bu.StrToFloat("-1.96"), "two", "0.049995790296441", false},
+		{"invalid tail returns error", bu.StrToFloat("1.96"), "center", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalPValue(tt.x, zero, one, tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NormalPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_NormalQuantile(t *testing.T) {
+	zero, one := bu.StrToFloat("0"), bu.StrToFloat("1")
+	tests := []struct {
+		name         string
+		probability  *big.Float
+		mean, stdDev *big.Float
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:        "median of the standard normal",
+			probability: bu.StrToFloat("0.5"), mean: zero, stdDev: one,
+			want: "0.0",
+		},
+		{
+			name:        "97.5th percentile",
+			probability: bu.StrToFloat("0.975"), mean: zero, stdDev: one,
+			want: "1.95996398454005423552",
+		},
+		{
+			name:        "5th percentile",
+			probability: bu.StrToFloat("0.05"), mean: zero, stdDev: one,
+			want: "-1.64485362695147271486",
+		},
+		{
+			name:        "far lower tail",
+			probability: bu.StrToFloat("1e-10"), mean: zero, stdDev: one,
+			want: "-6.36134090240405620470",
+		},
+		{
+			name:        "below float64 range",
+			probability: bu.StrToFloat("1e-400"), mean: zero, stdDev: one,
+			want: "-42.8102272066113",
+		},
+		{
+			name:        "N(100, 15) 90th percentile",
+			probability: bu.StrToFloat("0.9"), mean: bu.StrToFloat("100"), stdDev: bu.StrToFloat("15"),
+			want: "119.223273483169",
+		},
+		{
+			name:        "probability of 0 returns error",
+			probability: zero, mean: zero, stdDev: one,
+			wantErr: true,
+		},
+		{
+			name:        "probability of 1 returns error",
+			probability: one, mean: zero, stdDev: one,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalQuantile(tt.probability, tt.mean, tt.stdDev)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalQuantile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("NormalQuantile() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+// PoissonBinomialPMF returns P(X = 0) through P(X = n) for the number of successes in independent trials that each
+// have their own chance of success
+func PoissonBinomialPMF(probabilities []*big.Float) (pmf []big.Float, err error) {
+	if len(probabilities) == 0 {
+		return nil, errors.New("poisson binomial probabilities cannot be empty")
+	}
+	one := bu.StrToFloat("1")
+	for _, p := range probabilities {
+		if p == nil || p.Sign() == -1 || p.Cmp(one) > 0 {
+			return nil, errors.New("poisson binomial chance of success (p) must be between 0 and 1")
+		}
+	}
+	// After j trials, dist[i] = P(i successes). Adding trial j + 1 with chance p gives
+	// dist'[i] = dist[i] * (1 - p) + dist[i - 1] * p, which is updated from the top down so it can be done in place
+	dist := make([]*big.Float, len(probabilities)+1)
+	dist[0] = bu.PrecFloat().SetInt64(1)
+	for i := 1; i < len(dist); i++ {
+		dist[i] = bu.PrecFloat().SetInt64(0)
+	}
+	for j, p := range probabilities {
+		q := bu.PrecFloat().Sub(one, p)
+		for i := j + 1; i > 0; i-- {
+			dist[i].Mul(dist[i], q)
+			dist[i].Add(dist[i], bu.PrecFloat().Mul(dist[i-1], p))
+		}
+		dist[0].Mul(dist[0], q)
+	}
+	pmf = make([]big.Float, len(dist))
+	for i, term := range dist {
+		pmf[i] = *term
+	}
+	return pmf, nil
+}
+
+func PoissonBinomialProbability(probabilities []*big.Float, k int64) (probability big.Float, err error) {
+	if k < 0 {
+		return big.Float{}, errors.New("poisson binomial probability k cannot be negative")
+	}
+	if k > int64(len(probabilities)) {
+		return big.Float{}, errors.New("poisson binomial probability k cannot exceed the number of trials")
+	}
+	pmf, err := PoissonBinomialPMF(probabilities)
+	if err != nil {
+		return big.Float{}, err
+	}
+	return pmf[k], nil
+}
+
+func CumulativePoissonBinomialProbability(probabilities []*big.Float, k int64) (cumulative big.Float, terms []big.Float, err error) {
+	if k < 0 {
+		return big.Float{}, nil, errors.New("cumulative poisson binomial probability k cannot be negative")
+	}
+	if k > int64(len(probabilities)) {
+		return big.Float{}, nil, errors.New("cumulative poisson binomial probability k cannot exceed the number of trials")
+	}
+	pmf, err := PoissonBinomialPMF(probabilities)
+	if err != nil {
+		return big.Float{}, nil, err
+	}
+	acc := bu.PrecFloat().SetInt64(0)
+	for i := range k + 1 {
+		acc.Add(acc, &pmf[i])
+	}
+	return *acc, pmf[:k+1], nil
+}
+
+func PoissonBinomialPValue(probabilities []*big.Float, k int64, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	if k < 0 || k > int64(len(probabilities)) {
+		return big.Float{}, errors.New("poisson binomial p-value k must be between 0 and the number of trials")
+	}
+	pmf, err := PoissonBinomialPMF(probabilities)
+	if err != nil {
+		return big.Float{}, err
+	}
+	// Each tail is summed directly so a small one is not lost to 1 - (the other tail)
+	left, right := bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(0)
+	for i := range pmf {
+		if int64(i) <= k {
+			left.Add(left, &pmf[i])
+		}
+		if int64(i) >= k {
+			right.Add(right, &pmf[i])
+		}
+	}
+	switch tail {
+	case "left":
+		return *left, nil
+	case "right":
+		return *right, nil
+	}
+	return doubleSmallerTail(left, right), nil
+}
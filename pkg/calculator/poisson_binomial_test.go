@@ -0,0 +1,118 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_PoissonBinomialPMF(t *testing.T) {
+	pmf, err := PoissonBinomialPMF([]*big.Float{bu.StrToFloat("0.1"), bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.9"), bu.StrToFloat("0.25")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"0.010125", "0.129375", "0.38325", "0.36275", "0.106625", "0.007875"}
+	if len(pmf) != len(want) {
+		t.Fatalf("expected %d terms, got %d", len(want), len(pmf))
+	}
+	for i := range want {
+		if compare := bu.NewCompare(&pmf[i], want[i]); !compare.Equal() {
+			t.Errorf("pmf[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+		}
+	}
+}
+
+func Test_PoissonBinomialPMFMatchesBinomial(t *testing.T) {
+	probabilities := make([]*big.Float, 10)
+	for i := range probabilities {
+		probabilities[i] = bu.StrToFloat("0.3")
+	}
+	pmf, err := PoissonBinomialPMF(probabilities)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	binomial, err := CalculateBinomialProbability(bu.StrToFloat("0.3"), 10, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&pmf[4], bu.ToStr(&binomial, 40)); !compare.Equal() {
+		t.Errorf("pmf[4] = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_PoissonBinomialProbability(t *testing.T) {
+	trials := []*big.Float{bu.StrToFloat("0.1"), bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.9"), bu.StrToFloat("0.25")}
+	tests := []struct {
+		name          string
+		probabilities []*big.Float
+		k             int64
+		want          string
+		wantErr       bool
+	}{
+		{name: "It should return a single term", probabilities: trials, k: 3, want: "0.36275"},
+		{name: "It should return the last term", probabilities: trials, k: 5, want: "0.007875"},
+		{name: "It should reject a negative k", probabilities: trials, k: -1, wantErr: true},
+		{name: "It should reject k past the last trial", probabilities: trials, k: 6, wantErr: true},
+		{name: "It should reject empty probabilities", probabilities: nil, k: 0, wantErr: true},
+		{name: "It should reject a probability above 1", probabilities: []*big.Float{bu.StrToFloat("1.2")}, k: 0, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PoissonBinomialProbability(tt.probabilities, tt.k)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PoissonBinomialProbability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("PoissonBinomialProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_CumulativePoissonBinomialProbability(t *testing.T) {
+	trials := []*big.Float{bu.StrToFloat("0.1"), bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.9"), bu.StrToFloat("0.25")}
+	cumulative, terms, err := CumulativePoissonBinomialProbability(trials, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(terms) != 3 {
+		t.Errorf("expected 3 terms, got %d", len(terms))
+	}
+	if compare := bu.NewCompare(&cumulative, "0.52275"); !compare.Equal() {
+		t.Errorf("CumulativePoissonBinomialProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, _, err := CumulativePoissonBinomialProbability(trials, 6); err == nil {
+		t.Error("expected error for k past the number of trials")
+	}
+}
+
+func Test_PoissonBinomialPValue(t *testing.T) {
+	tests := []struct {
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{tail: "left", want: "0.52275"},
+		{tail: "right", want: "0.8605"},
+		{tail: "two", want: "1.0"},
+		{tail: "none", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tail, func(t *testing.T) {
+			got, err := PoissonBinomialPValue([]*big.Float{bu.StrToFloat("0.1"), bu.StrToFloat("0.5"), bu.StrToFloat("0.7"), bu.StrToFloat("0.9"), bu.StrToFloat("0.25")}, 2, tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PoissonBinomialPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("PoissonBinomialPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
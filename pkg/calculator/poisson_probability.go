@@ -0,0 +1,26 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func CalculatePoissonProbability(lambda *big.Float, occurrences int64) (probability big.Float, err error) {
+	if lambda.Sign() == -1 {
+		return big.Float{}, errors.New("poisson probability rate (lambda) cannot be negative")
+	}
+	if occurrences < 0 {
+		return big.Float{}, errors.New("poisson probability occurrences (k) cannot be negative")
+	}
+	// P(X = k) = e^-λ * λ^k / k!
+	eNegLambda := Exp(bu.PrecFloat().Neg(lambda))
+	lambdaPowK := IntPow(lambda, big.NewInt(occurrences))
+	kFactorial, err := Factorial(occurrences)
+	if err != nil {
+		return big.Float{}, err
+	}
+	numerator := bu.PrecFloat().Mul(eNegLambda, lambdaPowK)
+	return *bu.PrecFloat().Quo(numerator, bu.PrecFloat().SetInt(kFactorial)), nil
+}
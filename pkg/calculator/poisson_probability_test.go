@@ -0,0 +1,82 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_CalculatePoissonProbability(t *testing.T) {
+	type args = struct {
+		lambda      *big.Float
+		occurrences int64
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "It should return 0.1353352832 for lambda=2, k=0",
+			args: args{bu.StrToFloat("2"), 0},
+			want: "0.1353352832",
+		},
+		{
+			name: "It should return 0.1804470443 for lambda=2, k=3",
+			args: args{bu.StrToFloat("2"), 3},
+			want: "0.1804470443",
+		},
+		{
+			name: "It should return 0.3032653299 for lambda=0.5, k=1",
+			args: args{bu.StrToFloat("0.5"), 1},
+			want: "0.3032653299",
+		},
+		{
+			name: "It should return 0.1251100357 for lambda=10, k=10",
+			args: args{bu.StrToFloat("10"), 10},
+			want: "0.1251100357",
+		},
+		{
+			name: "It should return 0.0398609968 for lambda=100, k=100",
+			args: args{bu.StrToFloat("100"), 100},
+			want: "0.0398609968",
+		},
+		{
+			name: "It should return 1.0 for lambda=0, k=0",
+			args: args{bu.StrToFloat("0"), 0},
+			want: "1.0",
+		},
+		{
+			name: "It should return 0.0 for lambda=0, k=2",
+			args: args{bu.StrToFloat("0"), 2},
+			want: "0.0",
+		},
+		{
+			name:    "It should return an error when lambda < 0",
+			args:    args{bu.StrToFloat("-0.1"), 2},
+			wantErr: true,
+		},
+		{
+			name:    "It should return an error when k < 0",
+			args:    args{bu.StrToFloat("2"), -1},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculatePoissonProbability(tt.args.lambda, tt.args.occurrences)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculatePoissonProbability() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("CalculatePoissonProbability() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
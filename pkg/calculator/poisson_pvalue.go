@@ -0,0 +1,34 @@
+package calculator
+
+import (
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func PoissonPValue(lambda *big.Float, k int64, tail string) (pValue big.Float, err error) {
+	if err := ValidateTail(tail); err != nil {
+		return big.Float{}, err
+	}
+	left, _, err := CumulativePoissonProbability(lambda, k)
+	if err != nil {
+		return big.Float{}, err
+	}
+	if tail == "left" {
+		return left, nil
+	}
+	var right big.Float
+	if k == 0 {
+		right = *bu.StrToFloat("1")
+	} else {
+		rightCum, _, err := CumulativePoissonProbability(lambda, k-1)
+		if err != nil {
+			return big.Float{}, err
+		}
+		right = *bu.PrecFloat().Sub(bu.StrToFloat("1"), &rightCum)
+	}
+	if tail == "right" {
+		return right, nil
+	}
+	return doubleSmallerTail(&left, &right), nil
+}
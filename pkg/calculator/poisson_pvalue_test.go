@@ -0,0 +1,75 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_PoissonPValue(t *testing.T) {
+	four := bu.StrToFloat("4")
+	tests := []struct {
+		name    string
+		lambda  *big.Float
+		k       int64
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "left tail k=1",
+			lambda: four, k: 1, tail: "left",
+			want: "0.0915781944",
+		},
+		{
+			name:   "right tail k=7",
+			lambda: four, k: 7, tail: "right",
+			want: "0.1106739784",
+		},
+		{
+			name:   "right tail k=0 returns 1",
+			lambda: four, k: 0, tail: "right",
+			want: "1.0",
+		},
+		{
+			name:   "two-tailed k=7 doubles the right tail",
+			lambda: four, k: 7, tail: "two",
+			want: "0.2213479568",
+		},
+		{
+			name:   "two-tailed k=1 doubles the left tail",
+			lambda: four, k: 1, tail: "two",
+			want: "0.1831563889",
+		},
+		{
+			name:   "two-tailed capped at 1",
+			lambda: four, k: 4, tail: "two",
+			want: "1.0",
+		},
+		{
+			name:   "invalid tail returns error",
+			lambda: four, k: 1, tail: "center",
+			wantErr: true,
+		},
+		{
+			name:   "lambda < 0 returns error",
+			lambda: bu.StrToFloat("-1"), k: 1, tail: "left",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pval, err := PoissonPValue(tt.lambda, tt.k, tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PoissonPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&pval, tt.want); !compare.Equal() {
+				t.Errorf("PoissonPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
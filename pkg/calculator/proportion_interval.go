@@ -0,0 +1,118 @@
+package calculator
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func validateProportionInterval(n, k int64, confidence *big.Float) error {
+	if n <= 0 {
+		return errors.New("proportion interval trials (n) must be positive")
+	}
+	if k < 0 || k > n {
+		return errors.New("proportion interval successes (k) must be between 0 and n")
+	}
+	if confidence.Sign() != 1 || confidence.Cmp(bu.StrToFloat("1")) >= 0 {
+		return errors.New("proportion interval confidence level must be strictly between 0 and 1")
+	}
+	return nil
+}
+
+// The tail probability on each side of a two-sided interval, (1 - confidence)/2
+func intervalTail(confidence *big.Float) *big.Float {
+	return bu.PrecFloat().Quo(bu.PrecFloat().Sub(bu.StrToFloat("1"), confidence), bu.StrToFloat("2"))
+}
+
+// The exact interval inverts two one-sided binomial tests, which works out to quantiles of
+// Beta(k, n - k + 1) for the lower bound and Beta(k + 1, n - k) for the upper bound
+func ClopperPearsonInterval(n, k int64, confidence *big.Float) (lower big.Float, upper big.Float, err error) {
+	if err := validateProportionInterval(n, k, confidence); err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	tail := intervalTail(confidence)
+	return betaInterval(tail, bu.PrecFloat().SetInt64(k), bu.PrecFloat().SetInt64(n-k), bu.StrToFloat("1"), k, n)
+}
+
+// The Jeffreys interval is the equal-tailed credible interval under a Beta(1/2, 1/2) prior, Beta(k + 1/2, n - k + 1/2)
+func JeffreysInterval(n, k int64, confidence *big.Float) (lower big.Float, upper big.Float, err error) {
+	if err := validateProportionInterval(n, k, confidence); err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	tail := intervalTail(confidence)
+	half := bu.StrToFloat("0.5")
+	return betaInterval(tail, bu.PrecFloat().Add(bu.PrecFloat().SetInt64(k), half), bu.PrecFloat().Add(bu.PrecFloat().SetInt64(n-k), half), bu.StrToFloat("0"), k, n)
+}
+
+// betaInterval takes the lower bound from the lower tail quantile of Beta(successes, failures + offset) and the upper
+// bound from the upper tail quantile of Beta(successes + offset, failures). With k = 0 or k = n the corresponding
+// bound is pinned to 0 or 1
+func betaInterval(tail, successes, failures, offset *big.Float, k, n int64) (lower big.Float, upper big.Float, err error) {
+	lower, upper = *bu.PrecFloat().SetInt64(0), *bu.PrecFloat().SetInt64(1)
+	if k > 0 {
+		lower, err = betaQuantile(tail, successes, bu.PrecFloat().Add(failures, offset))
+		if err != nil {
+			return big.Float{}, big.Float{}, err
+		}
+	}
+	if k < n {
+		upperProbability := bu.PrecFloat().Sub(bu.StrToFloat("1"), tail)
+		upper, err = betaQuantile(upperProbability, bu.PrecFloat().Add(successes, offset), failures)
+		if err != nil {
+			return big.Float{}, big.Float{}, err
+		}
+	}
+	return lower, upper, nil
+}
+
+// The Wilson score interval solves |p̂ - p| = z * sqrt(p(1 - p)/n) for p:
+// (k + z^2/2)/(n + z^2) ± z/(n + z^2) * sqrt(k(n - k)/n + z^2/4)
+func WilsonInterval(n, k int64, confidence *big.Float) (lower big.Float, upper big.Float, err error) {
+	if err := validateProportionInterval(n, k, confidence); err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	z, err := standardNormalQuantile(bu.PrecFloat().Sub(bu.StrToFloat("1"), intervalTail(confidence)))
+	if err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	nFloat, kFloat := bu.PrecFloat().SetInt64(n), bu.PrecFloat().SetInt64(k)
+	zSquared := bu.PrecFloat().Mul(&z, &z)
+	denominator := bu.PrecFloat().Add(nFloat, zSquared)
+	centre := bu.PrecFloat().Add(kFloat, bu.PrecFloat().Quo(zSquared, bu.StrToFloat("2")))
+	centre.Quo(centre, denominator)
+	spread := bu.PrecFloat().Quo(bu.PrecFloat().Mul(kFloat, bu.PrecFloat().SetInt64(n-k)), nFloat)
+	spread.Add(spread, bu.PrecFloat().Quo(zSquared, bu.StrToFloat("4")))
+	halfWidth := bu.PrecFloat().Mul(&z, spread.Sqrt(spread))
+	halfWidth.Quo(halfWidth, denominator)
+	return *bu.PrecFloat().Sub(centre, halfWidth), *bu.PrecFloat().Add(centre, halfWidth), nil
+}
+
+// Agresti-Coull adds z^2/2 successes and z^2/2 failures, then uses the Wald interval:
+// p̃ ± z * sqrt(p̃(1 - p̃)/ñ) with ñ = n + z^2 and p̃ = (k + z^2/2)/ñ, clipped to [0, 1]
+func AgrestiCoullInterval(n, k int64, confidence *big.Float) (lower big.Float, upper big.Float, err error) {
+	if err := validateProportionInterval(n, k, confidence); err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	z, err := standardNormalQuantile(bu.PrecFloat().Sub(bu.StrToFloat("1"), intervalTail(confidence)))
+	if err != nil {
+		return big.Float{}, big.Float{}, err
+	}
+	one := bu.StrToFloat("1")
+	zSquared := bu.PrecFloat().Mul(&z, &z)
+	adjustedN := bu.PrecFloat().Add(bu.PrecFloat().SetInt64(n), zSquared)
+	adjustedP := bu.PrecFloat().Add(bu.PrecFloat().SetInt64(k), bu.PrecFloat().Quo(zSquared, bu.StrToFloat("2")))
+	adjustedP.Quo(adjustedP, adjustedN)
+	variance := bu.PrecFloat().Mul(adjustedP, bu.PrecFloat().Sub(one, adjustedP))
+	variance.Quo(variance, adjustedN)
+	halfWidth := bu.PrecFloat().Mul(&z, variance.Sqrt(variance))
+	lowerBound := bu.PrecFloat().Sub(adjustedP, halfWidth)
+	if lowerBound.Sign() == -1 {
+		lowerBound.SetInt64(0)
+	}
+	upperBound := bu.PrecFloat().Add(adjustedP, halfWidth)
+	if upperBound.Cmp(one) > 0 {
+		upperBound.SetInt64(1)
+	}
+	return *lowerBound, *upperBound, nil
+}
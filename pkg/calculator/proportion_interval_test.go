@@ -0,0 +1,62 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+type proportionIntervalFunc func(n, k int64, confidence *big.Float) (big.Float, big.Float, error)
+
+func Test_ProportionIntervals(t *testing.T) {
+	confidence := bu.StrToFloat("0.95")
+	tests := []struct {
+		name                 string
+		interval             proportionIntervalFunc
+		n, k                 int64
+		wantLower, wantUpper string
+	}{
+		{name: "Clopper-Pearson", interval: ClopperPearsonInterval, n: 20, k: 7, wantLower: "0.153909204784541", wantUpper: "0.592188534532828"},
+		{name: "Clopper-Pearson with no successes", interval: ClopperPearsonInterval, n: 20, k: 0, wantLower: "0.0", wantUpper: "0.168433470983085"},
+		{name: "Clopper-Pearson with all successes", interval: ClopperPearsonInterval, n: 20, k: 20, wantLower: "0.831566529016915", wantUpper: "1.0"},
+		{name: "Jeffreys", interval: JeffreysInterval, n: 20, k: 7, wantLower: "0.172276213631912", wantUpper: "0.567766093841496"},
+		{name: "Wilson", interval: WilsonInterval, n: 20, k: 7, wantLower: "0.181191824101082", wantUpper: "0.567145723314764"},
+		{name: "Agresti-Coull", interval: AgrestiCoullInterval, n: 20, k: 7, wantLower: "0.179926361438228", wantUpper: "0.568411185977618"},
+		{name: "Agresti-Coull is clipped at 0", interval: AgrestiCoullInterval, n: 20, k: 0, wantLower: "0.0", wantUpper: "0.189809560542489"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper, err := tt.interval(tt.n, tt.k, confidence)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&lower, tt.wantLower); !compare.Equal() {
+				t.Errorf("lower = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(&upper, tt.wantUpper); !compare.Equal() {
+				t.Errorf("upper = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_ProportionIntervalsRejectInvalidInput(t *testing.T) {
+	intervals := map[string]proportionIntervalFunc{
+		"Clopper-Pearson": ClopperPearsonInterval,
+		"Jeffreys":        JeffreysInterval,
+		"Wilson":          WilsonInterval,
+		"Agresti-Coull":   AgrestiCoullInterval,
+	}
+	for name, interval := range intervals {
+		if _, _, err := interval(10, 11, bu.StrToFloat("0.95")); err == nil {
+			t.Errorf("%s: expected error for k greater than n", name)
+		}
+		if _, _, err := interval(0, 0, bu.StrToFloat("0.95")); err == nil {
+			t.Errorf("%s: expected error for n of 0", name)
+		}
+		if _, _, err := interval(10, 3, bu.StrToFloat("1")); err == nil {
+			t.Errorf("%s: expected error for confidence of 1", name)
+		}
+	}
+}
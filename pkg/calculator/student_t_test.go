@@ -0,0 +1,101 @@
+package calculator
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_StudentTPDF(t *testing.T) {
+	got, err := StudentTPDF(bu.StrToFloat("1"), bu.StrToFloat("3"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(&got, "0.2067483357831720"); !compare.Equal() {
+		t.Errorf("StudentTPDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if _, err := StudentTPDF(bu.StrToFloat("1"), bu.StrToFloat("0")); err == nil {
+		t.Error("expected error for zero degrees of freedom")
+	}
+}
+
+func Test_StudentTCDF(t *testing.T) {
+	tests := []struct {
+		name                string
+		x, degreesOfFreedom *big.Float
+		want                string
+	}{
+		{name: "centre of the distribution", x: bu.StrToFloat("0"), degreesOfFreedom: bu.StrToFloat("4"), want: "0.5"},
+		{name: "positive t with odd df", x: bu.StrToFloat("2"), degreesOfFreedom: bu.StrToFloat("5"), want: "0.949030260585071"},
+		{name: "negative t with even df", x: bu.StrToFloat("-2.5"), degreesOfFreedom: bu.StrToFloat("10"), want: "0.015723422118304"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StudentTCDF(tt.x, tt.degreesOfFreedom)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("StudentTCDF() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_StudentTPValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       *big.Float
+		tail    string
+		want    string
+		wantErr bool
+	}{
+		{name: "left tail", x: bu.StrToFloat("-2.5"), tail: "left", want: "0.015723422118304"},
+		{name: "right tail", x: bu.StrToFloat("-2.5"), tail: "right", want: "0.984276577881696"},
+		{name: "two tails", x: bu.StrToFloat("-2.5"), tail: "two", want: "0.031446844236609"},
+		{name: "invalid tail returns error", x: bu.StrToFloat("1"), tail: "both", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StudentTPValue(tt.x, bu.StrToFloat("10"), tt.tail)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StudentTPValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("StudentTPValue() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_StudentTQuantile(t *testing.T) {
+	tests := []struct {
+		name                          string
+		probability, degreesOfFreedom *big.Float
+		want                          string
+		wantErr                       bool
+	}{
+		{name: "two-sided 95% critical value for 10 df", probability: bu.StrToFloat("0.975"), degreesOfFreedom: bu.StrToFloat("10"), want: "2.228138851986275"},
+		{name: "heavy tailed Cauchy case", probability: bu.StrToFloat("0.995"), degreesOfFreedom: bu.StrToFloat("1"), want: "63.65674116287158"},
+		{name: "lower tail", probability: bu.StrToFloat("0.05"), degreesOfFreedom: bu.StrToFloat("3"), want: "-2.353363434801824"},
+		{name: "probability of one returns error", probability: bu.StrToFloat("1"), degreesOfFreedom: bu.StrToFloat("3"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := StudentTQuantile(tt.probability, tt.degreesOfFreedom)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("StudentTQuantile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(&got, tt.want); !compare.Equal() {
+				t.Errorf("StudentTQuantile() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,289 @@
+package correlation
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/descriptive"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+type Method string
+
+const (
+	// Pearson's r, the linear correlation of the values themselves
+	PearsonCorrelation Method = "pearson"
+	// Spearman's ρ, Pearson's r between the ranks of x and the ranks of y
+	SpearmanCorrelation Method = "spearman"
+	// Kendall's τ-b, from the concordant and discordant pairs, adjusted for ties in either variable
+	KendallCorrelation Method = "kendall"
+)
+
+// When 1 - r² is this small, r is ±1 and the rest is rounding error
+var perfectTolerance = bu.StrToFloat("1e-60")
+
+// Options picks the tail of the test against zero correlation, "two" by default, and the confidence level of the
+// Pearson interval, 0.95 by default
+type Options struct {
+	Tail       string
+	Confidence *big.Float
+}
+
+// Result is a correlation coefficient with its test against zero correlation. For Pearson and Spearman the statistic
+// is t = r √(n - 2) / √(1 - r²) on n - 2 degrees of freedom, and it is nil for a perfect correlation, whose p-values
+// are 0 or 1. For Kendall it is the normal score of S = concordant - discordant pairs and DegreesOfFreedom is 0.
+// Lower and Upper are the Fisher-z confidence interval for Pearson's r and are nil for the other methods, or when
+// there are only three pairs
+type Result struct {
+	Method           Method
+	Coefficient      *big.Float
+	Statistic        *big.Float
+	DegreesOfFreedom int64
+	PValue           *big.Float
+	Lower, Upper     *big.Float
+	N                int64
+}
+
+// Pearson tests r = Σ (x - x̄)(y - ȳ) / √(Σ (x - x̄)² Σ (y - ȳ)²), assuming the pairs are bivariate normal
+func Pearson[T op.Number | op.BigNumber](x, y []T, options ...Options) (result Result, err error) {
+	xs, ys, err := pairs(x, y)
+	if err != nil {
+		return Result{}, err
+	}
+	option, err := resolveOptions(options)
+	if err != nil {
+		return Result{}, err
+	}
+	r, err := pearson(xs, ys)
+	if err != nil {
+		return Result{}, err
+	}
+	result, err = tTest(PearsonCorrelation, r, len(xs), option.Tail)
+	if err != nil {
+		return Result{}, err
+	}
+	result.Lower, result.Upper, err = fisherInterval(r, len(xs), option.Confidence)
+	if err != nil {
+		return Result{}, err
+	}
+	return result, nil
+}
+
+// Spearman tests ρ, Pearson's r between the ranks, with tied values sharing their average rank. The p-value uses the
+// t approximation, which holds up from about ten pairs and does not need the values to be normal
+func Spearman[T op.Number | op.BigNumber](x, y []T, options ...Options) (result Result, err error) {
+	xs, ys, err := pairs(x, y)
+	if err != nil {
+		return Result{}, err
+	}
+	option, err := resolveOptions(options)
+	if err != nil {
+		return Result{}, err
+	}
+	rho, err := spearman(xs, ys)
+	if err != nil {
+		return Result{}, err
+	}
+	return tTest(SpearmanCorrelation, rho, len(xs), option.Tail)
+}
+
+// Matrix returns the k × k matrix of correlations between the columns of data, where data[i] holds the k values of
+// observation i. The diagonal is 1
+func Matrix[T op.Number | op.BigNumber](data [][]T, method Method) (correlations *matrix.BigMatrix[*big.Float], err error) {
+	if len(data) < 3 {
+		return nil, errors.New("correlation needs at least three observations")
+	}
+	k := len(data[0])
+	if k == 0 {
+		return nil, errors.New("correlation matrix needs at least one column")
+	}
+	columns := make([][]*big.Float, k)
+	for _, row := range data {
+		if len(row) != k {
+			return nil, errors.New("correlation observations must all have the same number of columns")
+		}
+		floats, err := op.ToBigFloats(row)
+		if err != nil {
+			return nil, err
+		}
+		for j, value := range floats {
+			columns[j] = append(columns[j], value)
+		}
+	}
+	var coefficient func(x, y []*big.Float) (*big.Float, error)
+	switch method {
+	case PearsonCorrelation, "":
+		coefficient = pearson
+	case SpearmanCorrelation:
+		coefficient = spearman
+	case KendallCorrelation:
+		coefficient = func(x, y []*big.Float) (*big.Float, error) {
+			tau, _, _, err := kendall(x, y)
+			return tau, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown correlation method %q", method)
+	}
+	rows := make([][]*big.Float, k)
+	for i := range rows {
+		rows[i] = make([]*big.Float, k)
+	}
+	for i := range k {
+		for j := i; j < k; j++ {
+			if i == j {
+				if !varies(columns[i]) {
+					return nil, errors.New("correlation is undefined for a column that never varies")
+				}
+				rows[i][i] = bu.PrecFloat().SetInt64(1)
+				continue
+			}
+			value, err := coefficient(columns[i], columns[j])
+			if err != nil {
+				return nil, err
+			}
+			rows[i][j], rows[j][i] = value, bu.PrecFloat().Set(value)
+		}
+	}
+	return matrix.NewBigMatrix(rows)
+}
+
+func pairs[T op.Number | op.BigNumber](x, y []T) (xs, ys []*big.Float, err error) {
+	if len(x) != len(y) {
+		return nil, nil, errors.New("correlation needs the same number of x and y observations")
+	}
+	if len(x) < 3 {
+		return nil, nil, errors.New("correlation needs at least three pairs")
+	}
+	if xs, err = op.ToBigFloats(x); err != nil {
+		return nil, nil, err
+	}
+	if ys, err = op.ToBigFloats(y); err != nil {
+		return nil, nil, err
+	}
+	return xs, ys, nil
+}
+
+func resolveOptions(options []Options) (Options, error) {
+	option := Options{Tail: "two", Confidence: bu.StrToFloat("0.95")}
+	if len(options) > 0 {
+		if options[0].Tail != "" {
+			option.Tail = options[0].Tail
+		}
+		if options[0].Confidence != nil {
+			option.Confidence = options[0].Confidence
+		}
+	}
+	if err := calculator.ValidateTail(option.Tail); err != nil {
+		return Options{}, err
+	}
+	if option.Confidence.Sign() != 1 || option.Confidence.Cmp(bu.StrToFloat("1")) >= 0 {
+		return Options{}, errors.New("correlation confidence level must be strictly between 0 and 1")
+	}
+	return option, nil
+}
+
+func pearson(x, y []*big.Float) (*big.Float, error) {
+	xMean, yMean := mean(x), mean(y)
+	sxy, sxx, syy := bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(0)
+	for i := range x {
+		dx, dy := bu.PrecFloat().Sub(x[i], xMean), bu.PrecFloat().Sub(y[i], yMean)
+		sxy.Add(sxy, bu.PrecFloat().Mul(dx, dy))
+		sxx.Add(sxx, bu.PrecFloat().Mul(dx, dx))
+		syy.Add(syy, bu.PrecFloat().Mul(dy, dy))
+	}
+	if sxx.Sign() == 0 || syy.Sign() == 0 {
+		return nil, errors.New("correlation is undefined when x or y never varies")
+	}
+	return sxy.Quo(sxy, bu.PrecFloat().Sqrt(sxx.Mul(sxx, syy))), nil
+}
+
+func spearman(x, y []*big.Float) (*big.Float, error) {
+	xRanks, err := descriptive.Ranks(x)
+	if err != nil {
+		return nil, err
+	}
+	yRanks, err := descriptive.Ranks(y)
+	if err != nil {
+		return nil, err
+	}
+	return pearson(xRanks, yRanks)
+}
+
+// tTest takes t = r √(n - 2) / √(1 - r²) on n - 2 degrees of freedom. At r = ±1 the statistic is infinite, so the
+// one-sided p-value in the direction of r is 0 and the other is 1
+func tTest(method Method, r *big.Float, n int, tail string) (result Result, err error) {
+	result = Result{Method: method, Coefficient: r, DegreesOfFreedom: int64(n - 2), N: int64(n)}
+	one := bu.StrToFloat("1")
+	remaining := bu.PrecFloat().Sub(one, bu.PrecFloat().Mul(r, r))
+	if remaining.Cmp(perfectTolerance) <= 0 {
+		result.Coefficient = bu.PrecFloat().SetInt64(int64(r.Sign()))
+		pValue := bu.PrecFloat().SetInt64(0)
+		if (tail == "left" && r.Sign() == 1) || (tail == "right" && r.Sign() == -1) {
+			pValue.SetInt64(1)
+		}
+		result.PValue = pValue
+		return result, nil
+	}
+	df := bu.PrecFloat().SetInt64(result.DegreesOfFreedom)
+	result.Statistic = bu.PrecFloat().Mul(r, bu.PrecFloat().Sqrt(df))
+	result.Statistic.Quo(result.Statistic, bu.PrecFloat().Sqrt(remaining))
+	pValue, err := calculator.StudentTPValue(result.Statistic, df, tail)
+	if err != nil {
+		return Result{}, err
+	}
+	result.PValue = &pValue
+	return result, nil
+}
+
+// fisherInterval transforms r to z = atanh r = ln((1 + r) / (1 - r)) / 2, which is close to normal with standard
+// error 1 / √(n - 3), and maps z ± z_(α/2) / √(n - 3) back through tanh
+func fisherInterval(r *big.Float, n int, confidence *big.Float) (lower, upper *big.Float, err error) {
+	if n <= 3 {
+		return nil, nil, nil
+	}
+	one := bu.StrToFloat("1")
+	if bu.PrecFloat().Sub(one, bu.PrecFloat().Mul(r, r)).Cmp(perfectTolerance) <= 0 {
+		perfect := bu.PrecFloat().SetInt64(int64(r.Sign()))
+		return perfect, bu.PrecFloat().Set(perfect), nil
+	}
+	ratio, err := calculator.Ln(bu.PrecFloat().Quo(bu.PrecFloat().Add(one, r), bu.PrecFloat().Sub(one, r)))
+	if err != nil {
+		return nil, nil, err
+	}
+	z := ratio.Quo(ratio, bu.StrToFloat("2"))
+	probability := bu.PrecFloat().Quo(bu.PrecFloat().Add(one, confidence), bu.StrToFloat("2"))
+	critical, err := calculator.NormalQuantile(probability, bu.PrecFloat().SetInt64(0), one)
+	if err != nil {
+		return nil, nil, err
+	}
+	margin := bu.PrecFloat().Quo(&critical, bu.PrecFloat().Sqrt(bu.PrecFloat().SetInt64(int64(n-3))))
+	return tanh(bu.PrecFloat().Sub(z, margin)), tanh(bu.PrecFloat().Add(z, margin)), nil
+}
+
+// tanh z = (e^(2z) - 1) / (e^(2z) + 1)
+func tanh(z *big.Float) *big.Float {
+	one := bu.StrToFloat("1")
+	growth := calculator.Exp(bu.PrecFloat().Mul(z, bu.StrToFloat("2")))
+	return bu.PrecFloat().Quo(bu.PrecFloat().Sub(growth, one), bu.PrecFloat().Add(growth, one))
+}
+
+func mean(values []*big.Float) *big.Float {
+	total := bu.PrecFloat().SetInt64(0)
+	for _, value := range values {
+		total.Add(total, value)
+	}
+	return total.Quo(total, bu.PrecFloat().SetInt64(int64(len(values))))
+}
+
+func varies(values []*big.Float) bool {
+	for _, value := range values[1:] {
+		if value.Cmp(values[0]) != 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,156 @@
+package correlation
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Pearson(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	y := []float64{2.5, 1, 4, 3.5, 7, 8, 6, 9, 12.5, 10}
+	tests := []struct {
+		name    string
+		options Options
+		want    []string
+	}{
+		{
+			"It should default to a two-tailed test and a 95% interval",
+			Options{},
+			[]string{"0.917729468955996432874543062512", "6.534973783646050440411602652222", "0.000181309830987168798871241387", "0.682428536696121643114078579943", "0.980688092878554040503458872721"},
+		},
+		{
+			"It should take the tail and confidence level from the options",
+			Options{Tail: "right", Confidence: bu.StrToFloat("0.8")},
+			[]string{"0.917729468955996432874543062512", "6.534973783646050440411602652222", "0.000090654915493584399435620694", "0.796900461365289213329638302011", "0.967956169755728707766259617856"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Pearson(x, y, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, value := range []*big.Float{got.Coefficient, got.Statistic, got.PValue, got.Lower, got.Upper} {
+				if compare := bu.NewCompare(value, tt.want[i]); !compare.Equal() {
+					t.Errorf("Pearson()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+			if got.DegreesOfFreedom != 8 || got.N != 10 {
+				t.Errorf("Pearson() df = %d, n = %d, want 8 and 10", got.DegreesOfFreedom, got.N)
+			}
+		})
+	}
+}
+
+func Test_PearsonPerfect(t *testing.T) {
+	tests := []struct {
+		name string
+		y    []int
+		tail string
+		want []string
+	}{
+		{"It should give a perfect positive correlation a p-value of 0", []int{3, 5, 7, 9}, "two", []string{"1", "0", "1", "1"}},
+		{"It should give the opposite tail a p-value of 1", []int{3, 5, 7, 9}, "left", []string{"1", "1", "1", "1"}},
+		{"It should handle a perfect negative correlation", []int{9, 7, 5, 3}, "left", []string{"-1", "0", "-1", "-1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Pearson([]int{1, 2, 3, 4}, tt.y, Options{Tail: tt.tail})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Statistic != nil {
+				t.Errorf("Pearson() statistic = %v, want nil", got.Statistic)
+			}
+			for i, value := range []*big.Float{got.Coefficient, got.PValue, got.Lower, got.Upper} {
+				if compare := bu.NewCompare(value, tt.want[i]); !compare.Equal() {
+					t.Errorf("Pearson()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+		})
+	}
+}
+
+func Test_Spearman(t *testing.T) {
+	x := []int{1, 2, 2, 3, 4, 5, 5, 6, 7, 8}
+	y := []int{3, 1, 2, 2, 5, 6, 4, 6, 8, 7}
+	got, err := Spearman(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"0.877300613496932515337423312883", "5.170175911344638766001275381538", "0.000852952854153683266577716345"}
+	for i, value := range []*big.Float{got.Coefficient, got.Statistic, got.PValue} {
+		if compare := bu.NewCompare(value, want[i]); !compare.Equal() {
+			t.Errorf("Spearman()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+		}
+	}
+	if got.Lower != nil || got.Upper != nil {
+		t.Errorf("Spearman() should not report an interval")
+	}
+}
+
+func Test_Matrix(t *testing.T) {
+	data := [][]float64{
+		{1, 2.5, -1}, {2, 1, -2}, {3, 4, -3}, {4, 3.5, -4}, {5, 7, -5},
+		{6, 8, -6}, {7, 6, -7}, {8, 9, -8}, {9, 12.5, -9}, {10, 10, -10},
+	}
+	tests := []struct {
+		method Method
+		xy     string
+	}{
+		{PearsonCorrelation, "0.917729468955996432874543062512"},
+		{SpearmanCorrelation, "0.927272727272727272727272727273"},
+		{KendallCorrelation, "0.777777777777777777777777777778"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.method), func(t *testing.T) {
+			got, err := Matrix(data, tt.method)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			rows := got.GetRows()
+			want := []string{"1", tt.xy, tt.xy, "-1", "1", "1"}
+			for i, value := range []*big.Float{rows[0][0], rows[0][1], rows[1][0], rows[0][2], rows[1][1], rows[2][2]} {
+				if compare := bu.NewCompare(value, want[i]); !compare.Equal() {
+					t.Errorf("Matrix()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+			if compare := bu.NewCompare(rows[1][2], "-"+tt.xy); !compare.Equal() {
+				t.Errorf("Matrix()[1][2] = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_CorrelationErrors(t *testing.T) {
+	if _, err := Pearson([]int{1, 2, 3}, []int{4, 4, 4}); err == nil {
+		t.Errorf("Pearson() expected an error when y never varies")
+	}
+	if _, err := Pearson([]int{1, 2}, []int{1, 2}); err == nil {
+		t.Errorf("Pearson() expected an error for two pairs")
+	}
+	if _, err := Spearman([]int{1, 2, 3}, []int{1, 2}); err == nil {
+		t.Errorf("Spearman() expected an error for mismatched lengths")
+	}
+	if _, err := Pearson([]int{1, 2, 3}, []int{1, 3, 2}, Options{Tail: "both"}); err == nil {
+		t.Errorf("Pearson() expected an error for an unknown tail")
+	}
+	if _, err := Pearson([]int{1, 2, 3}, []int{1, 3, 2}, Options{Confidence: bu.StrToFloat("1")}); err == nil {
+		t.Errorf("Pearson() expected an error for a confidence level of 1")
+	}
+	if _, err := Spearman([]float64{1, 2, 3}, []float64{1, math.NaN(), 2}); err == nil {
+		t.Errorf("Spearman() expected an error for NaN")
+	}
+	if _, err := Matrix([][]float64{{1, 2}, {2, math.Inf(1)}, {3, 2}}, KendallCorrelation); err == nil {
+		t.Errorf("Matrix() expected an error for an infinite value")
+	}
+	if _, err := Matrix([][]int{{1, 2}, {2, 2}, {3, 2}}, PearsonCorrelation); err == nil {
+		t.Errorf("Matrix() expected an error for a constant column")
+	}
+	if _, err := Matrix([][]int{{1, 2}, {2, 1}, {3, 3}}, "distance"); err == nil {
+		t.Errorf("Matrix() expected an error for an unknown method")
+	}
+}
@@ -0,0 +1,95 @@
+package correlation
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/descriptive"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Kendall tests τ-b = S / √((n0 - n1)(n0 - n2)), where S is the number of concordant pairs minus the number of
+// discordant ones, n0 = n(n - 1)/2 and n1, n2 count the pairs tied in x and in y. The p-value is the normal
+// approximation to S with its variance corrected for ties
+func Kendall[T op.Number | op.BigNumber](x, y []T, options ...Options) (result Result, err error) {
+	xs, ys, err := pairs(x, y)
+	if err != nil {
+		return Result{}, err
+	}
+	option, err := resolveOptions(options)
+	if err != nil {
+		return Result{}, err
+	}
+	tau, s, variance, err := kendall(xs, ys)
+	if err != nil {
+		return Result{}, err
+	}
+	result = Result{Method: KendallCorrelation, Coefficient: tau, N: int64(len(xs))}
+	result.Statistic = bu.PrecFloat().Quo(s, bu.PrecFloat().Sqrt(variance))
+	pValue, err := calculator.NormalPValue(result.Statistic, bu.PrecFloat().SetInt64(0), bu.StrToFloat("1"), option.Tail)
+	if err != nil {
+		return Result{}, err
+	}
+	result.PValue = &pValue
+	return result, nil
+}
+
+// kendall returns τ-b, S and the variance of S under independence,
+// Var(S) = (v0 - vt - vu) / 18 + v1 / (2n(n - 1)) + v2 / (9n(n - 1)(n - 2)), with v0 = n(n - 1)(2n + 5),
+// vt = Σ t(t - 1)(2t + 5) over the groups of t tied x values and vu the same for y,
+// v1 = Σ t(t - 1) Σ u(u - 1) and v2 = Σ t(t - 1)(t - 2) Σ u(u - 1)(u - 2)
+func kendall(x, y []*big.Float) (tau, s, variance *big.Float, err error) {
+	n := int64(len(x))
+	concordance := int64(0)
+	for i := range x {
+		for j := i + 1; j < len(x); j++ {
+			concordance += int64(x[i].Cmp(x[j]) * y[i].Cmp(y[j]))
+		}
+	}
+	xTies, yTies := descriptive.TieCounts(x), descriptive.TieCounts(y)
+	pairCount := n * (n - 1) / 2
+	xUntied, yUntied := pairCount-tiedPairs(xTies), pairCount-tiedPairs(yTies)
+	if xUntied == 0 || yUntied == 0 {
+		return nil, nil, nil, errors.New("correlation is undefined when x or y never varies")
+	}
+	s = bu.PrecFloat().SetInt64(concordance)
+	tau = bu.PrecFloat().Quo(s, bu.PrecFloat().Sqrt(product(xUntied, yUntied)))
+
+	// The tie sums are at most n^3, but their products grow like n^6 and pass int64 at a few thousand tied pairs
+	weighted := func(t int64) int64 { return t * (t - 1) * (2*t + 5) }
+	ordered := func(t int64) int64 { return t * (t - 1) }
+	triples := func(t int64) int64 { return t * (t - 1) * (t - 2) }
+	v0 := product(n, n-1, 2*n+5)
+	vt, vu := tieSum(xTies, weighted), tieSum(yTies, weighted)
+	v1 := product(tieSum(xTies, ordered), tieSum(yTies, ordered))
+	v2 := product(tieSum(xTies, triples), tieSum(yTies, triples))
+	variance = v0.Sub(v0, bu.PrecFloat().SetInt64(vt+vu))
+	variance.Quo(variance, bu.StrToFloat("18"))
+	variance.Add(variance, v1.Quo(v1, product(2, n, n-1)))
+	variance.Add(variance, v2.Quo(v2, product(9, n, n-1, n-2)))
+	return tau, s, variance, nil
+}
+
+// tiedPairs counts the pairs within groups of ties, Σ t(t - 1)/2
+func tiedPairs(ties []int64) int64 {
+	return tieSum(ties, func(t int64) int64 { return t * (t - 1) / 2 })
+}
+
+func tieSum(ties []int64, term func(t int64) int64) int64 {
+	total := int64(0)
+	for _, t := range ties {
+		total += term(t)
+	}
+	return total
+}
+
+// product multiplies the factors as big.Float, which holds them exactly at the working precision
+func product(factors ...int64) *big.Float {
+	result := bu.PrecFloat().SetInt64(1)
+	for _, factor := range factors {
+		result.Mul(result, bu.PrecFloat().SetInt64(factor))
+	}
+	return result
+}
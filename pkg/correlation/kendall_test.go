@@ -0,0 +1,70 @@
+package correlation
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Kendall(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+		tail string
+		want []string
+	}{
+		{
+			"It should test tau without ties",
+			[]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, []float64{2.5, 1, 4, 3.5, 7, 8, 6, 9, 12.5, 10}, "two",
+			[]string{"0.777777777777777777777777777778", "3.130495168499705574972843136224", "0.001745118699528904988135567947"},
+		},
+		{
+			"It should correct tau and its variance for ties",
+			[]float64{1, 2, 2, 3, 4, 5, 5, 6, 7, 8}, []float64{3, 1, 2, 2, 5, 6, 4, 6, 8, 7}, "two",
+			[]string{"0.720930232558139534883720930233", "2.817147243323833886076077967477", "0.004845230710962475842680730672"},
+		},
+		{
+			"It should give the left tail",
+			[]float64{1, 2, 2, 3, 4, 5, 5, 6, 7, 8}, []float64{3, 1, 2, 2, 5, 6, 4, 6, 8, 7}, "left",
+			[]string{"0.720930232558139534883720930233", "2.817147243323833886076077967477", "0.997577384644518762078659634664"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Kendall(tt.x, tt.y, Options{Tail: tt.tail})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i, value := range []*big.Float{got.Coefficient, got.Statistic, got.PValue} {
+				if compare := bu.NewCompare(value, tt.want[i]); !compare.Equal() {
+					t.Errorf("Kendall()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+		})
+	}
+	if _, err := Kendall([]int{2, 2, 2}, []int{1, 2, 3}); err == nil {
+		t.Errorf("Kendall() expected an error when x never varies")
+	}
+}
+
+func Test_KendallManyTies(t *testing.T) {
+	// 3000 binary pairs: Σ t(t - 1)(t - 2) Σ u(u - 1)(u - 2) is about 4.5e19, past the int64 range
+	x, y := make([]int, 3000), make([]int, 3000)
+	for i := range x {
+		x[i] = i % 2
+		if i%5 < 2+x[i] {
+			y[i] = 1
+		}
+	}
+	got, err := Kendall(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"0.2", "10.952625256074454043020462517844", "0.0000000000000000000000000006454922000953"}
+	for i, value := range []*big.Float{got.Coefficient, got.Statistic, got.PValue} {
+		if compare := bu.NewCompare(value, want[i]); !compare.Equal() {
+			t.Errorf("Kendall()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+		}
+	}
+}
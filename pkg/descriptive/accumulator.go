@@ -0,0 +1,143 @@
+package descriptive
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Accumulator keeps running statistics over values it sees one at a time, without holding on to them.
+// It is not safe for concurrent use: give each goroutine its own and Merge them when they finish
+type Accumulator[T op.Number | op.BigNumber] struct {
+	count int64
+	mean  *big.Float
+	// Σ (x - mean)² over everything added so far
+	squares *big.Float
+	min     *big.Float
+	max     *big.Float
+}
+
+func NewAccumulator[T op.Number | op.BigNumber]() *Accumulator[T] {
+	return &Accumulator[T]{
+		mean:    bu.PrecFloat().SetInt64(0),
+		squares: bu.PrecFloat().SetInt64(0),
+	}
+}
+
+// Add folds in one value with Welford's update:
+// mean_n = mean_(n-1) + (x - mean_(n-1)) / n
+// M2_n = M2_(n-1) + (x - mean_(n-1))(x - mean_n)
+// It stops at the first NaN or ±Inf and returns an error, keeping the values before it
+func (a *Accumulator[T]) Add(values ...T) error {
+	for _, value := range values {
+		x, err := op.ToBigFloat(value)
+		if err != nil {
+			return err
+		}
+		a.count++
+		delta := bu.PrecFloat().Sub(x, a.mean)
+		a.mean.Add(a.mean, bu.PrecFloat().Quo(delta, bu.PrecFloat().SetInt64(a.count)))
+		a.squares.Add(a.squares, delta.Mul(delta, bu.PrecFloat().Sub(x, a.mean)))
+		if a.min == nil || x.Cmp(a.min) < 0 {
+			a.min = x
+		}
+		if a.max == nil || x.Cmp(a.max) > 0 {
+			a.max = x
+		}
+	}
+	return nil
+}
+
+// Merge folds another accumulator's values into this one, as if they had all been added here. With δ the difference
+// of the two means:
+// mean = mean_a + δ n_b / n
+// M2 = M2_a + M2_b + δ² n_a n_b / n
+func (a *Accumulator[T]) Merge(other *Accumulator[T]) {
+	if other == nil || other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		a.count = other.count
+		a.mean = bu.PrecFloat().Set(other.mean)
+		a.squares = bu.PrecFloat().Set(other.squares)
+		a.min = bu.PrecFloat().Set(other.min)
+		a.max = bu.PrecFloat().Set(other.max)
+		return
+	}
+	countA := bu.PrecFloat().SetInt64(a.count)
+	countB := bu.PrecFloat().SetInt64(other.count)
+	count := bu.PrecFloat().Add(countA, countB)
+	delta := bu.PrecFloat().Sub(other.mean, a.mean)
+
+	a.mean.Add(a.mean, bu.PrecFloat().Quo(bu.PrecFloat().Mul(delta, countB), count))
+	correction := bu.PrecFloat().Mul(delta, delta)
+	correction.Mul(correction, countA)
+	correction.Mul(correction, countB)
+	correction.Quo(correction, count)
+	a.squares.Add(a.squares, other.squares)
+	a.squares.Add(a.squares, correction)
+	a.count += other.count
+	if other.min.Cmp(a.min) < 0 {
+		a.min = bu.PrecFloat().Set(other.min)
+	}
+	if other.max.Cmp(a.max) > 0 {
+		a.max = bu.PrecFloat().Set(other.max)
+	}
+}
+
+func (a *Accumulator[T]) Count() int64 {
+	return a.count
+}
+
+func (a *Accumulator[T]) Mean() (*big.Float, error) {
+	if a.count == 0 {
+		return nil, errors.New("accumulator has no values")
+	}
+	return bu.PrecFloat().Set(a.mean), nil
+}
+
+func (a *Accumulator[T]) PopulationVariance() (*big.Float, error) {
+	if a.count == 0 {
+		return nil, errors.New("accumulator has no values")
+	}
+	return bu.PrecFloat().Quo(a.squares, bu.PrecFloat().SetInt64(a.count)), nil
+}
+
+func (a *Accumulator[T]) SampleVariance() (*big.Float, error) {
+	if a.count < 2 {
+		return nil, errors.New("sample variance needs at least two values")
+	}
+	return bu.PrecFloat().Quo(a.squares, bu.PrecFloat().SetInt64(a.count-1)), nil
+}
+
+func (a *Accumulator[T]) PopulationStandardDeviation() (*big.Float, error) {
+	variance, err := a.PopulationVariance()
+	if err != nil {
+		return nil, err
+	}
+	return variance.Sqrt(variance), nil
+}
+
+func (a *Accumulator[T]) SampleStandardDeviation() (*big.Float, error) {
+	variance, err := a.SampleVariance()
+	if err != nil {
+		return nil, err
+	}
+	return variance.Sqrt(variance), nil
+}
+
+func (a *Accumulator[T]) Min() (*big.Float, error) {
+	if a.count == 0 {
+		return nil, errors.New("accumulator has no values")
+	}
+	return bu.PrecFloat().Set(a.min), nil
+}
+
+func (a *Accumulator[T]) Max() (*big.Float, error) {
+	if a.count == 0 {
+		return nil, errors.New("accumulator has no values")
+	}
+	return bu.PrecFloat().Set(a.max), nil
+}
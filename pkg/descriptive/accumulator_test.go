@@ -0,0 +1,151 @@
+package descriptive
+
+import (
+	"math"
+	"math/big"
+	"sync"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Accumulator(t *testing.T) {
+	accumulator := NewAccumulator[int]()
+	if err := accumulator.Add(2, 4, 4, 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := accumulator.Add(5, 5, 7, 9); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if accumulator.Count() != 8 {
+		t.Errorf("Count() = %d, want 8", accumulator.Count())
+	}
+	tests := []struct {
+		name      string
+		statistic func() (*big.Float, error)
+		want      string
+	}{
+		{"mean", accumulator.Mean, "5"},
+		{"population variance", accumulator.PopulationVariance, "4"},
+		{"sample variance", accumulator.SampleVariance, "4.571428571428571428571428571429"},
+		{"population standard deviation", accumulator.PopulationStandardDeviation, "2"},
+		{"sample standard deviation", accumulator.SampleStandardDeviation, "2.138089935299395"},
+		{"min", accumulator.Min, "2"},
+		{"max", accumulator.Max, "9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.statistic()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("%s = %v, want %v", tt.name, compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_AccumulatorEmpty(t *testing.T) {
+	accumulator := NewAccumulator[float64]()
+	if _, err := accumulator.Mean(); err == nil {
+		t.Errorf("Mean() expected an error with no values")
+	}
+	if _, err := accumulator.Min(); err == nil {
+		t.Errorf("Min() expected an error with no values")
+	}
+	if err := accumulator.Add(1.5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := accumulator.SampleVariance(); err == nil {
+		t.Errorf("SampleVariance() expected an error with one value")
+	}
+}
+
+func Test_AccumulatorNonFinite(t *testing.T) {
+	tests := []struct {
+		name  string
+		value float64
+	}{
+		{"It should reject NaN", math.NaN()},
+		{"It should reject +Inf", math.Inf(1)},
+		{"It should reject -Inf", math.Inf(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accumulator := NewAccumulator[float64]()
+			if err := accumulator.Add(1, tt.value, 3); err == nil {
+				t.Fatalf("Add() expected an error")
+			}
+			if accumulator.Count() != 1 {
+				t.Errorf("Count() = %d, want 1", accumulator.Count())
+			}
+			if err := accumulator.Add(3); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			mean, err := accumulator.Mean()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(mean, "2"); !compare.Equal() {
+				t.Errorf("Mean() = %v, want 2", compare.ActualAsString)
+			}
+		})
+	}
+}
+
+func Test_AccumulatorMerge(t *testing.T) {
+	values := make([]*big.Float, 1000)
+	for i := range values {
+		// A large offset is where the naive sum-of-squares formula loses everything
+		values[i] = bu.PrecFloat().SetFloat64(1e12 + float64(i*i%97)/7)
+	}
+	sequential := NewAccumulator[*big.Float]()
+	if err := sequential.Add(values...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parts := make([]*Accumulator[*big.Float], 4)
+	var wait sync.WaitGroup
+	for part := range parts {
+		parts[part] = NewAccumulator[*big.Float]()
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			if err := parts[part].Add(values[part*250 : (part+1)*250]...); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wait.Wait()
+	merged := NewAccumulator[*big.Float]()
+	for _, part := range parts {
+		merged.Merge(part)
+	}
+	merged.Merge(NewAccumulator[*big.Float]())
+
+	if merged.Count() != sequential.Count() {
+		t.Fatalf("Count() = %d, want %d", merged.Count(), sequential.Count())
+	}
+	wantVariance, err := SampleVariance(values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, accumulator := range map[string]*Accumulator[*big.Float]{"sequential": sequential, "merged": merged} {
+		t.Run(name, func(t *testing.T) {
+			variance, _ := accumulator.SampleVariance()
+			if compare := bu.NewCompare(variance, bu.ToStr(wantVariance, 40)); !compare.Equal() {
+				t.Errorf("SampleVariance() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			mean, _ := accumulator.Mean()
+			wantMean, _ := Mean(values)
+			if compare := bu.NewCompare(mean, bu.ToStr(wantMean, 40)); !compare.Equal() {
+				t.Errorf("Mean() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			min, _ := accumulator.Min()
+			if min.Cmp(bu.PrecFloat().SetFloat64(1e12)) != 0 {
+				t.Errorf("Min() = %v, want 1e12", min)
+			}
+		})
+	}
+}
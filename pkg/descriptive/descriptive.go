@@ -0,0 +1,280 @@
+package descriptive
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Summary describes a dataset. Statistics that are undefined for it are left nil: the sample variance and standard
+// deviation need at least two values, and skewness and kurtosis need values that are not all the same.
+// Mode holds every value tied for the highest count, smallest first, and is empty when no value repeats.
+// The median and quartiles are type 7 quantiles
+type Summary struct {
+	Count                       int
+	Sum                         *big.Float
+	Mean                        *big.Float
+	PopulationVariance          *big.Float
+	SampleVariance              *big.Float
+	PopulationStandardDeviation *big.Float
+	SampleStandardDeviation     *big.Float
+	Min                         *big.Float
+	Max                         *big.Float
+	Median                      *big.Float
+	Mode                        []*big.Float
+	FirstQuartile               *big.Float
+	ThirdQuartile               *big.Float
+	InterquartileRange          *big.Float
+	Skewness                    *big.Float
+	Kurtosis                    *big.Float
+}
+
+func Describe[T op.Number | op.BigNumber](values []T) (summary Summary, err error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return Summary{}, err
+	}
+	sorted := sortedCopy(data)
+	mean := average(data)
+	m2 := centralMoment(data, mean, 2)
+	summary = Summary{
+		Count:              len(data),
+		Sum:                sum(data),
+		Mean:               mean,
+		PopulationVariance: m2,
+		Min:                sorted[0],
+		Max:                sorted[len(sorted)-1],
+		Median:             quantile(sorted, bu.StrToFloat("0.5"), QuantileType7),
+		Mode:               mode(sorted),
+	}
+	summary.PopulationStandardDeviation = bu.PrecFloat().Sqrt(m2)
+	if len(data) > 1 {
+		summary.SampleVariance = sampleVariance(data, mean)
+		summary.SampleStandardDeviation = bu.PrecFloat().Sqrt(summary.SampleVariance)
+	}
+	summary.FirstQuartile, summary.ThirdQuartile = quartiles(sorted, QuantileType7)
+	summary.InterquartileRange = bu.PrecFloat().Sub(summary.ThirdQuartile, summary.FirstQuartile)
+	if m2.Sign() == 1 {
+		summary.Skewness = skewness(data, mean, m2)
+		summary.Kurtosis = kurtosis(data, mean, m2)
+	}
+	return summary, nil
+}
+
+func Sum[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := op.ToBigFloats(values)
+	if err != nil {
+		return nil, err
+	}
+	return sum(data), nil
+}
+
+func Mean[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return average(data), nil
+}
+
+// σ² = Σ (x - mean)² / n
+func PopulationVariance[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return centralMoment(data, average(data), 2), nil
+}
+
+// s² = Σ (x - mean)² / (n - 1)
+func SampleVariance[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := op.ToBigFloats(values)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 {
+		return nil, errors.New("sample variance needs at least two values")
+	}
+	return sampleVariance(data, average(data)), nil
+}
+
+func PopulationStandardDeviation[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	variance, err := PopulationVariance(values)
+	if err != nil {
+		return nil, err
+	}
+	return variance.Sqrt(variance), nil
+}
+
+func SampleStandardDeviation[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	variance, err := SampleVariance(values)
+	if err != nil {
+		return nil, err
+	}
+	return variance.Sqrt(variance), nil
+}
+
+func Min[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return slices.MinFunc(data, (*big.Float).Cmp), nil
+}
+
+func Max[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return slices.MaxFunc(data, (*big.Float).Cmp), nil
+}
+
+func Median[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return quantile(sortedCopy(data), bu.StrToFloat("0.5"), QuantileType7), nil
+}
+
+func Mode[T op.Number | op.BigNumber](values []T) ([]*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	return mode(sortedCopy(data)), nil
+}
+
+// Quartiles uses type 7, the definition R and spreadsheets use by default, unless another method is given
+func Quartiles[T op.Number | op.BigNumber](values []T, method ...QuantileMethod) (first, third *big.Float, err error) {
+	quartiles, err := Quantiles(values, []*big.Float{bu.StrToFloat("0.25"), bu.StrToFloat("0.75")}, quantileMethod(method))
+	if err != nil {
+		return nil, nil, err
+	}
+	return quartiles[0], quartiles[1], nil
+}
+
+func InterquartileRange[T op.Number | op.BigNumber](values []T, method ...QuantileMethod) (*big.Float, error) {
+	first, third, err := Quartiles(values, method...)
+	if err != nil {
+		return nil, err
+	}
+	return third.Sub(third, first), nil
+}
+
+// Skewness is the moment coefficient g1 = m3 / m2^(3/2), where m_k is the k-th central moment
+func Skewness[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, mean, m2, err := spread(values)
+	if err != nil {
+		return nil, err
+	}
+	return skewness(data, mean, m2), nil
+}
+
+// Kurtosis is the excess kurtosis g2 = m4 / m2² - 3, which is 0 for a normal distribution
+func Kurtosis[T op.Number | op.BigNumber](values []T) (*big.Float, error) {
+	data, mean, m2, err := spread(values)
+	if err != nil {
+		return nil, err
+	}
+	return kurtosis(data, mean, m2), nil
+}
+
+// nonEmpty converts a dataset that has at least one value, all of them finite
+func nonEmpty[T op.Number | op.BigNumber](values []T) ([]*big.Float, error) {
+	if len(values) == 0 {
+		return nil, errors.New("dataset must have at least one value")
+	}
+	return op.ToBigFloats(values)
+}
+
+// spread returns what skewness and kurtosis are built from, failing when every value is the same
+func spread[T op.Number | op.BigNumber](values []T) (data []*big.Float, mean, m2 *big.Float, err error) {
+	data, err = nonEmpty(values)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	mean = average(data)
+	m2 = centralMoment(data, mean, 2)
+	if m2.Sign() == 0 {
+		return nil, nil, nil, errors.New("dataset with no variance has no skewness or kurtosis")
+	}
+	return data, mean, m2, nil
+}
+
+func sum(data []*big.Float) *big.Float {
+	total := bu.PrecFloat().SetInt64(0)
+	for _, value := range data {
+		total.Add(total, value)
+	}
+	return total
+}
+
+func average(data []*big.Float) *big.Float {
+	return bu.PrecFloat().Quo(sum(data), bu.PrecFloat().SetInt64(int64(len(data))))
+}
+
+// m_k = Σ (x - mean)^k / n
+func centralMoment(data []*big.Float, mean *big.Float, k int) *big.Float {
+	total := bu.PrecFloat().SetInt64(0)
+	for _, value := range data {
+		deviation := bu.PrecFloat().Sub(value, mean)
+		power := bu.PrecFloat().SetInt64(1)
+		for range k {
+			power.Mul(power, deviation)
+		}
+		total.Add(total, power)
+	}
+	return total.Quo(total, bu.PrecFloat().SetInt64(int64(len(data))))
+}
+
+func sampleVariance(data []*big.Float, mean *big.Float) *big.Float {
+	n := bu.PrecFloat().SetInt64(int64(len(data)))
+	variance := centralMoment(data, mean, 2)
+	variance.Mul(variance, n)
+	return variance.Quo(variance, n.Sub(n, bu.StrToFloat("1")))
+}
+
+func skewness(data []*big.Float, mean, m2 *big.Float) *big.Float {
+	denominator := bu.PrecFloat().Mul(m2, bu.PrecFloat().Sqrt(m2))
+	return bu.PrecFloat().Quo(centralMoment(data, mean, 3), denominator)
+}
+
+func kurtosis(data []*big.Float, mean, m2 *big.Float) *big.Float {
+	ratio := bu.PrecFloat().Quo(centralMoment(data, mean, 4), bu.PrecFloat().Mul(m2, m2))
+	return ratio.Sub(ratio, bu.StrToFloat("3"))
+}
+
+func sortedCopy(data []*big.Float) []*big.Float {
+	sorted := slices.Clone(data)
+	slices.SortFunc(sorted, (*big.Float).Cmp)
+	return sorted
+}
+
+func quartiles(sorted []*big.Float, method QuantileMethod) (first, third *big.Float) {
+	return quantile(sorted, bu.StrToFloat("0.25"), method), quantile(sorted, bu.StrToFloat("0.75"), method)
+}
+
+func mode(sorted []*big.Float) []*big.Float {
+	modes := []*big.Float{}
+	best := 1
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Cmp(sorted[start]) == 0 {
+			end++
+		}
+		switch count := end - start; {
+		case count > best:
+			best = count
+			modes = []*big.Float{sorted[start]}
+		case count == best && best > 1:
+			modes = append(modes, sorted[start])
+		}
+		start = end
+	}
+	return modes
+}
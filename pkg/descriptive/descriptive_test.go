@@ -0,0 +1,182 @@
+package descriptive
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Describe(t *testing.T) {
+	summary, err := Describe([]int{2, 4, 4, 4, 5, 5, 7, 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.Count != 8 {
+		t.Errorf("Describe() count = %d, want 8", summary.Count)
+	}
+	tests := []struct {
+		name string
+		got  *big.Float
+		want string
+	}{
+		{"sum", summary.Sum, "40"},
+		{"mean", summary.Mean, "5"},
+		{"population variance", summary.PopulationVariance, "4"},
+		{"sample variance", summary.SampleVariance, "4.571428571428571428571428571429"},
+		{"population standard deviation", summary.PopulationStandardDeviation, "2"},
+		{"sample standard deviation", summary.SampleStandardDeviation, "2.138089935299395"},
+		{"min", summary.Min, "2"},
+		{"max", summary.Max, "9"},
+		{"median", summary.Median, "4.5"},
+		{"first quartile", summary.FirstQuartile, "4"},
+		{"third quartile", summary.ThirdQuartile, "5.5"},
+		{"interquartile range", summary.InterquartileRange, "1.5"},
+		{"skewness", summary.Skewness, "0.65625"},
+		{"kurtosis", summary.Kurtosis, "-0.21875"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got == nil {
+				t.Fatalf("Describe() %s is nil", tt.name)
+			}
+			if compare := bu.NewCompare(tt.got, tt.want); !compare.Equal() {
+				t.Errorf("Describe() %s = %v, want %v", tt.name, compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+	if len(summary.Mode) != 1 || summary.Mode[0].Cmp(bu.StrToFloat("4")) != 0 {
+		t.Errorf("Describe() mode = %v, want [4]", summary.Mode)
+	}
+}
+
+func Test_DescribeSingleValue(t *testing.T) {
+	summary, err := Describe([]float64{3.5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if summary.SampleVariance != nil || summary.SampleStandardDeviation != nil {
+		t.Errorf("Describe() sample variance should be undefined for one value")
+	}
+	if summary.Skewness != nil || summary.Kurtosis != nil {
+		t.Errorf("Describe() skewness and kurtosis should be undefined without variance")
+	}
+	if compare := bu.NewCompare(summary.Median, "3.5"); !compare.Equal() {
+		t.Errorf("Describe() median = %v, want 3.5", compare.ActualAsString)
+	}
+	if len(summary.Mode) != 0 {
+		t.Errorf("Describe() mode = %v, want none", summary.Mode)
+	}
+}
+
+func Test_DescribeInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+	}{
+		{"It should reject an empty dataset", []float64{}},
+		{"It should reject NaN", []float64{1, math.NaN(), 3}},
+		{"It should reject +Inf", []float64{1, math.Inf(1), 3}},
+		{"It should reject -Inf", []float64{1, math.Inf(-1), 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Describe(tt.values); err == nil {
+				t.Errorf("Describe() expected an error")
+			}
+			if _, err := SampleVariance(tt.values); err == nil {
+				t.Errorf("SampleVariance() expected an error")
+			}
+		})
+	}
+}
+
+func Test_Moments(t *testing.T) {
+	values := []*big.Float{bu.StrToFloat("1.5"), bu.StrToFloat("2.25"), bu.StrToFloat("3"), bu.StrToFloat("10.125")}
+	tests := []struct {
+		name      string
+		statistic func([]*big.Float) (*big.Float, error)
+		want      string
+	}{
+		{"mean", Mean[*big.Float], "4.21875"},
+		{"population variance", PopulationVariance[*big.Float], "11.9091796875"},
+		{"sample variance", SampleVariance[*big.Float], "15.87890625"},
+		{"population standard deviation", PopulationStandardDeviation[*big.Float], "3.450967934869867781744988251502"},
+		{"sample standard deviation", SampleStandardDeviation[*big.Float], "3.984834532323770153462318581379"},
+		{"median", Median[*big.Float], "2.625"},
+		{"interquartile range", func(values []*big.Float) (*big.Float, error) { return InterquartileRange(values) }, "2.71875"},
+		{"skewness", Skewness[*big.Float], "1.073619849619514155271707786799"},
+		{"kurtosis", Kurtosis[*big.Float], "-0.728341934341852643618687109380"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.statistic(values)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("%s = %v, want %v", tt.name, compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_Quartiles(t *testing.T) {
+	first, third, err := Quartiles([]*big.Int{big.NewInt(7), big.NewInt(1), big.NewInt(3), big.NewInt(15), big.NewInt(9)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(first, "3"); !compare.Equal() {
+		t.Errorf("Quartiles() first = %v, want 3", compare.ActualAsString)
+	}
+	if compare := bu.NewCompare(third, "9"); !compare.Equal() {
+		t.Errorf("Quartiles() third = %v, want 9", compare.ActualAsString)
+	}
+}
+
+func Test_Mode(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []string
+	}{
+		{"It should return the most common value", []int{3, 1, 3, 2}, []string{"3"}},
+		{"It should return every value tied for most common", []int{5, 1, 5, 1, 2}, []string{"1", "5"}},
+		{"It should return no mode when nothing repeats", []int{4, 1, 2}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Mode(tt.values)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Mode() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if compare := bu.NewCompare(got[i], tt.want[i]); !compare.Equal() {
+					t.Errorf("Mode()[%d] = %v, want %v", i, compare.ActualAsString, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_Errors(t *testing.T) {
+	if _, err := SampleVariance([]int{1}); err == nil {
+		t.Errorf("SampleVariance() expected an error for one value")
+	}
+	if _, err := Skewness([]int{2, 2, 2}); err == nil {
+		t.Errorf("Skewness() expected an error without variance")
+	}
+	if _, err := Min([]float64{}); err == nil {
+		t.Errorf("Min() expected an error for an empty dataset")
+	}
+	if got, err := Sum([]int{}); err != nil || got.Sign() != 0 {
+		t.Errorf("Sum() of nothing = %v, %v, want 0", got, err)
+	}
+	if _, err := Sum([]float64{math.Inf(1), math.Inf(-1)}); err == nil {
+		t.Errorf("Sum() expected an error for infinite values")
+	}
+}
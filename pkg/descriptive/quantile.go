@@ -0,0 +1,267 @@
+package descriptive
+
+import (
+	"errors"
+	"math/big"
+	"slices"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// QuantileMethod is one of the nine sample quantile definitions in Hyndman and Fan (1996), numbered as they and R's
+// quantile(type = ...) number them
+type QuantileMethod int
+
+const (
+	// Inverse of the empirical CDF
+	QuantileType1 QuantileMethod = iota + 1
+	// Inverse of the empirical CDF, averaging where it is flat
+	QuantileType2
+	// The nearest order statistic, ties to even (SAS definition 2)
+	QuantileType3
+	// Linear interpolation of the empirical CDF
+	QuantileType4
+	// Piecewise linear through the midpoints of the empirical CDF's steps
+	QuantileType5
+	// p_k = k / (n + 1), as in Minitab and SPSS. Excel's PERCENTILE.EXC uses the same positions but returns #NUM!
+	// for p outside [1/(n + 1), n/(n + 1)], where this holds at the first or last value instead
+	QuantileType6
+	// p_k = (k - 1) / (n - 1), the default in R, NumPy and Excel's PERCENTILE.INC
+	QuantileType7
+	// p_k = (k - 1/3) / (n + 1/3), approximately median-unbiased whatever the distribution
+	QuantileType8
+	// p_k = (k - 3/8) / (n + 1/4), approximately unbiased for normal data
+	QuantileType9
+)
+
+// Excel's PERCENTILE.INC. PERCENTILE.EXC has no alias because it rejects the probabilities that type 6 clamps
+const QuantileExcelInclusive = QuantileType7
+
+// Positions this close to a whole number are treated as one, so that p = 0.1 with n = 10 lands on x_(1) exactly
+// rather than a rounding error away from it
+var quantileFuzz = bu.StrToFloat("1e-60")
+
+func Quantile[T op.Number | op.BigNumber](values []T, p *big.Float, method QuantileMethod) (*big.Float, error) {
+	quantiles, err := Quantiles(values, []*big.Float{p}, method)
+	if err != nil {
+		return nil, err
+	}
+	return quantiles[0], nil
+}
+
+// Quantiles sorts the values once and returns the quantile at each probability in turn
+func Quantiles[T op.Number | op.BigNumber](values []T, probabilities []*big.Float, method QuantileMethod) ([]*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	if method < QuantileType1 || method > QuantileType9 {
+		return nil, errors.New("quantile method must be one of types 1 through 9")
+	}
+	if err := validateProbabilities(probabilities); err != nil {
+		return nil, err
+	}
+	sorted := sortedCopy(data)
+	quantiles := make([]*big.Float, len(probabilities))
+	for i, p := range probabilities {
+		quantiles[i] = quantile(sorted, p, method)
+	}
+	return quantiles, nil
+}
+
+func quantileMethod(method []QuantileMethod) QuantileMethod {
+	if len(method) == 0 {
+		return QuantileType7
+	}
+	return method[0]
+}
+
+func validateProbabilities(probabilities []*big.Float) error {
+	one := bu.StrToFloat("1")
+	for _, p := range probabilities {
+		if p == nil || p.Sign() == -1 || p.Cmp(one) > 0 {
+			return errors.New("quantile probability (p) must be between 0 and 1")
+		}
+	}
+	return nil
+}
+
+// quantile finds the 1-based position h of the quantile among the order statistics and returns
+// (1 - γ) x_(⌊h⌋) + γ x_(⌊h⌋ + 1), where the discontinuous types 1-3 only allow γ to be 0, 1/2 or 1 and the
+// continuous types 4-9 take γ = h - ⌊h⌋ with h = α + p(n + 1 - α - β)
+func quantile(sorted []*big.Float, p *big.Float, method QuantileMethod) *big.Float {
+	n := bu.PrecFloat().SetInt64(int64(len(sorted)))
+	var h *big.Float
+	switch method {
+	case QuantileType1, QuantileType2:
+		h = bu.PrecFloat().Mul(n, p)
+	case QuantileType3:
+		h = bu.PrecFloat().Mul(n, p)
+		h.Sub(h, bu.StrToFloat("0.5"))
+	default:
+		alpha, beta := plottingParameters(method)
+		h = bu.PrecFloat().Add(n, bu.StrToFloat("1"))
+		h.Sub(h, alpha)
+		h.Sub(h, beta)
+		h.Mul(h, p)
+		h.Add(h, alpha)
+	}
+
+	j, gamma := splitPosition(h)
+	switch method {
+	case QuantileType1:
+		if gamma.Sign() == 1 {
+			gamma.SetInt64(1)
+		}
+	case QuantileType2:
+		if gamma.Sign() == 1 {
+			gamma.SetInt64(1)
+		} else {
+			gamma.SetFloat64(0.5)
+		}
+	case QuantileType3:
+		if gamma.Sign() == 1 || j%2 == 1 {
+			gamma.SetInt64(1)
+		}
+	}
+	lower, upper := orderStatistic(sorted, j), orderStatistic(sorted, j+1)
+	if gamma.Sign() == 0 {
+		return bu.PrecFloat().Set(lower)
+	}
+	result := bu.PrecFloat().Sub(upper, lower)
+	result.Mul(result, gamma)
+	return result.Add(result, lower)
+}
+
+// The α and β of the continuous types, where the k-th order statistic sits at p_k = (k - α) / (n + 1 - α - β)
+func plottingParameters(method QuantileMethod) (alpha, beta *big.Float) {
+	third := bu.PrecFloat().Quo(bu.StrToFloat("1"), bu.StrToFloat("3"))
+	switch method {
+	case QuantileType4:
+		return bu.StrToFloat("0"), bu.StrToFloat("1")
+	case QuantileType5:
+		return bu.StrToFloat("0.5"), bu.StrToFloat("0.5")
+	case QuantileType6:
+		return bu.StrToFloat("0"), bu.StrToFloat("0")
+	case QuantileType8:
+		return third, third
+	case QuantileType9:
+		return bu.StrToFloat("0.375"), bu.StrToFloat("0.375")
+	}
+	return bu.StrToFloat("1"), bu.StrToFloat("1")
+}
+
+// splitPosition returns ⌊h⌋ and h - ⌊h⌋, snapping positions within the fuzz of a whole number onto it
+func splitPosition(h *big.Float) (j int64, gamma *big.Float) {
+	nearest := bu.PrecFloat().Add(h, bu.StrToFloat("0.5"))
+	rounded, _ := nearest.Int64()
+	if nearest.Sign() == -1 && !nearest.IsInt() {
+		rounded--
+	}
+	difference := bu.PrecFloat().Sub(h, bu.PrecFloat().SetInt64(rounded))
+	if difference.Abs(difference).Cmp(quantileFuzz) < 0 {
+		return rounded, bu.PrecFloat().SetInt64(0)
+	}
+	floor, _ := h.Int64()
+	if h.Sign() == -1 && !h.IsInt() {
+		floor--
+	}
+	return floor, bu.PrecFloat().Sub(h, bu.PrecFloat().SetInt64(floor))
+}
+
+// orderStatistic returns x_(k), 1-based, holding at the first and last values past either end
+func orderStatistic(sorted []*big.Float, k int64) *big.Float {
+	if k < 1 {
+		return sorted[0]
+	}
+	if k > int64(len(sorted)) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[k-1]
+}
+
+// WeightedQuantile generalises the types that are defined through the empirical CDF to weighted data, where each
+// value counts in proportion to its weight. With every weight equal they agree with Quantile:
+// type 1 is the smallest value whose cumulative weight reaches pW, type 2 averages where the weighted CDF is flat,
+// type 4 interpolates between the points (S_k / W, x_(k)) and type 5 between (S_k - w_k / 2) / W, where S_k is the
+// cumulative weight up to the k-th smallest value and W is the total weight
+func WeightedQuantile[T op.Number | op.BigNumber, W op.Number | op.BigNumber](values []T, weights []W, p *big.Float, method QuantileMethod) (*big.Float, error) {
+	if len(values) != len(weights) {
+		return nil, errors.New("weighted quantile values and weights must have the same length")
+	}
+	if method != QuantileType1 && method != QuantileType2 && method != QuantileType4 && method != QuantileType5 {
+		return nil, errors.New("weighted quantile method must be type 1, 2, 4 or 5")
+	}
+	if err := validateProbabilities([]*big.Float{p}); err != nil {
+		return nil, err
+	}
+	type weighted struct{ value, weight *big.Float }
+	data := make([]weighted, 0, len(values))
+	total := bu.PrecFloat().SetInt64(0)
+	for i, value := range values {
+		weight, err := op.ToBigFloat(weights[i])
+		if err != nil {
+			return nil, err
+		}
+		if weight.Sign() == -1 {
+			return nil, errors.New("weighted quantile weights cannot be negative")
+		}
+		// A value with no weight has no effect on the weighted CDF
+		if weight.Sign() == 0 {
+			continue
+		}
+		float, err := op.ToBigFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, weighted{float, weight})
+		total.Add(total, weight)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("weighted quantile needs at least one positive weight")
+	}
+	slices.SortStableFunc(data, func(a, b weighted) int { return a.value.Cmp(b.value) })
+
+	target := bu.PrecFloat().Mul(p, total)
+	cumulative := bu.PrecFloat().SetInt64(0)
+	positions := make([]*big.Float, len(data))
+	for k, point := range data {
+		cumulative.Add(cumulative, point.weight)
+		switch method {
+		case QuantileType1, QuantileType2:
+			difference := bu.PrecFloat().Sub(cumulative, target)
+			if difference.Sign() == -1 && difference.Abs(difference).Cmp(quantileFuzz) >= 0 {
+				continue
+			}
+			if method == QuantileType2 && difference.Cmp(quantileFuzz) < 0 && k < len(data)-1 {
+				average := bu.PrecFloat().Add(point.value, data[k+1].value)
+				return average.Quo(average, bu.StrToFloat("2")), nil
+			}
+			return bu.PrecFloat().Set(point.value), nil
+		case QuantileType4:
+			positions[k] = bu.PrecFloat().Quo(cumulative, total)
+		case QuantileType5:
+			midpoint := bu.PrecFloat().Quo(point.weight, bu.StrToFloat("2"))
+			positions[k] = midpoint.Quo(midpoint.Sub(cumulative, midpoint), total)
+		}
+	}
+	if method == QuantileType1 || method == QuantileType2 {
+		return bu.PrecFloat().Set(data[len(data)-1].value), nil
+	}
+
+	if p.Cmp(positions[0]) <= 0 {
+		return bu.PrecFloat().Set(data[0].value), nil
+	}
+	for k := 1; k < len(data); k++ {
+		if p.Cmp(positions[k]) > 0 {
+			continue
+		}
+		gamma := bu.PrecFloat().Sub(p, positions[k-1])
+		gamma.Quo(gamma, bu.PrecFloat().Sub(positions[k], positions[k-1]))
+		result := bu.PrecFloat().Sub(data[k].value, data[k-1].value)
+		result.Mul(result, gamma)
+		return result.Add(result, data[k-1].value), nil
+	}
+	return bu.PrecFloat().Set(data[len(data)-1].value), nil
+}
@@ -0,0 +1,129 @@
+package descriptive
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Quantiles(t *testing.T) {
+	values := []int{7, 1, 3, 15, 9, 3, 22, 11}
+	probabilities := []*big.Float{bu.StrToFloat("0"), bu.StrToFloat("0.1"), bu.StrToFloat("0.25"), bu.StrToFloat("0.5"), bu.StrToFloat("0.9"), bu.StrToFloat("1")}
+	tests := []struct {
+		method QuantileMethod
+		want   []string
+	}{
+		{QuantileType1, []string{"1", "1", "3", "7", "22", "22"}},
+		{QuantileType2, []string{"1", "1", "3", "8", "22", "22"}},
+		{QuantileType3, []string{"1", "1", "3", "7", "15", "22"}},
+		{QuantileType4, []string{"1", "1", "3", "7", "16.4", "22"}},
+		{QuantileType5, []string{"1", "1.6", "3", "8", "19.9", "22"}},
+		{QuantileType6, []string{"1", "1", "3", "8", "22", "22"}},
+		{QuantileType7, []string{"1", "2.4", "3", "8", "17.1", "22"}},
+		{QuantileType8, []string{"1", "1.333333333333333333333333333333", "3", "8", "20.833333333333333333333333333333", "22"}},
+		{QuantileType9, []string{"1", "1.4", "3", "8", "20.6", "22"}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("type %d", tt.method), func(t *testing.T) {
+			got, err := Quantiles(values, probabilities, tt.method)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i := range tt.want {
+				if compare := bu.NewCompare(got[i], tt.want[i]); !compare.Equal() {
+					t.Errorf("Quantiles()[p = %v] = %v, want %v", probabilities[i], compare.ActualAsString, compare.Expected)
+				}
+			}
+		})
+	}
+}
+
+func Test_QuantileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		p      string
+		method QuantileMethod
+	}{
+		{"It should reject an empty dataset", []float64{}, "0.5", QuantileType7},
+		{"It should reject a probability above 1", []float64{1, 2}, "1.5", QuantileType7},
+		{"It should reject an unknown method", []float64{1, 2}, "0.5", QuantileMethod(10)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Quantile(tt.values, bu.StrToFloat(tt.p), tt.method); err == nil {
+				t.Errorf("Quantile() expected an error")
+			}
+		})
+	}
+}
+
+func Test_QuartilesMethod(t *testing.T) {
+	first, third, err := Quartiles([]int{7, 1, 3, 15, 9, 3, 22, 11}, QuantileType6)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if compare := bu.NewCompare(first, "3"); !compare.Equal() {
+		t.Errorf("Quartiles() first = %v, want 3", compare.ActualAsString)
+	}
+	if compare := bu.NewCompare(third, "14"); !compare.Equal() {
+		t.Errorf("Quartiles() third = %v, want 14", compare.ActualAsString)
+	}
+}
+
+func Test_WeightedQuantileMatchesUnweighted(t *testing.T) {
+	values := []int{7, 1, 3, 15, 9, 3, 22, 11}
+	weights := []int{1, 1, 1, 1, 1, 1, 1, 1}
+	for _, method := range []QuantileMethod{QuantileType1, QuantileType2, QuantileType4, QuantileType5} {
+		for _, p := range []string{"0", "0.1", "0.25", "0.5", "0.9", "1"} {
+			want, _ := Quantile(values, bu.StrToFloat(p), method)
+			got, err := WeightedQuantile(values, weights, bu.StrToFloat(p), method)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if compare := bu.NewCompare(got, bu.ToStr(want, 40)); !compare.Equal() {
+				t.Errorf("WeightedQuantile(type %d, p = %s) = %v, want %v", method, p, compare.ActualAsString, compare.Expected)
+			}
+		}
+	}
+}
+
+func Test_WeightedQuantile(t *testing.T) {
+	values := []float64{3, 1, 2}
+	tests := []struct {
+		name    string
+		weights []*big.Float
+		p       string
+		method  QuantileMethod
+		want    string
+		wantErr bool
+	}{
+		{name: "It should invert the weighted CDF", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "0.5", method: QuantileType1, want: "1"},
+		{name: "It should average where the weighted CDF is flat", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "0.5", method: QuantileType2, want: "1.5"},
+		{name: "It should interpolate the weighted CDF", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "0.6", method: QuantileType4, want: "1.4"},
+		{name: "It should not depend on the scale of the weights", weights: []*big.Float{bu.StrToFloat("0.25"), bu.StrToFloat("0.5"), bu.StrToFloat("0.25")}, p: "0.6", method: QuantileType4, want: "1.4"},
+		{name: "It should interpolate between step midpoints", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "0.5", method: QuantileType5, want: "1.666666666666666666666666666667"},
+		{name: "It should ignore values with no weight", weights: []*big.Float{bu.StrToFloat("0"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "1", method: QuantileType1, want: "2"},
+		{name: "It should reject a method without a weighted form", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("1")}, p: "0.5", method: QuantileType7, wantErr: true},
+		{name: "It should reject a negative weight", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("-2"), bu.StrToFloat("1")}, p: "0.5", method: QuantileType1, wantErr: true},
+		{name: "It should reject weights that are all zero", weights: []*big.Float{bu.StrToFloat("0"), bu.StrToFloat("0"), bu.StrToFloat("0")}, p: "0.5", method: QuantileType1, wantErr: true},
+		{name: "It should reject an infinite weight", weights: []*big.Float{bu.StrToFloat("1"), new(big.Float).SetInf(false), bu.StrToFloat("1")}, p: "0.5", method: QuantileType1, wantErr: true},
+		{name: "It should reject mismatched weights", weights: []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2")}, p: "0.5", method: QuantileType1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WeightedQuantile(values, tt.weights, bu.StrToFloat(tt.p), tt.method)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("WeightedQuantile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if compare := bu.NewCompare(got, tt.want); !compare.Equal() {
+				t.Errorf("WeightedQuantile() = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package descriptive
+
+import (
+	"math/big"
+	"slices"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Ranks returns the rank of each value in its original position, 1 for the smallest. Tied values all get the average
+// of the ranks they span, so 10, 20, 20, 30 ranks as 1, 2.5, 2.5, 4
+func Ranks[T op.Number | op.BigNumber](values []T) ([]*big.Float, error) {
+	data, err := nonEmpty(values)
+	if err != nil {
+		return nil, err
+	}
+	order := make([]int, len(data))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return data[a].Cmp(data[b]) })
+	ranks := make([]*big.Float, len(data))
+	for start := 0; start < len(order); {
+		end := tieEnd(data, order, start)
+		// Positions start..end-1 hold ranks start+1..end, whose average is (start + end + 1) / 2
+		rank := bu.PrecFloat().SetInt64(int64(start + end + 1))
+		rank.Quo(rank, bu.StrToFloat("2"))
+		for _, index := range order[start:end] {
+			ranks[index] = bu.PrecFloat().Set(rank)
+		}
+		start = end
+	}
+	return ranks, nil
+}
+
+// TieCounts returns the size of every group of equal values that has more than one member, in increasing order of
+// value. These are the t in the Σ (t³ - t) tie corrections of rank tests
+func TieCounts(values []*big.Float) []int64 {
+	sorted := sortedCopy(values)
+	counts := []int64{}
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Cmp(sorted[start]) == 0 {
+			end++
+		}
+		if end-start > 1 {
+			counts = append(counts, int64(end-start))
+		}
+		start = end
+	}
+	return counts
+}
+
+func tieEnd(data []*big.Float, order []int, start int) int {
+	end := start + 1
+	for end < len(order) && data[order[end]].Cmp(data[order[start]]) == 0 {
+		end++
+	}
+	return end
+}
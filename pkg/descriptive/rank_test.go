@@ -0,0 +1,56 @@
+package descriptive
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Ranks(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []*big.Float
+		want   []string
+		ties   []int64
+	}{
+		{
+			"It should rank distinct values by order",
+			[]*big.Float{bu.StrToFloat("3.5"), bu.StrToFloat("-1"), bu.StrToFloat("10")},
+			[]string{"2", "1", "3"},
+			[]int64{},
+		},
+		{
+			"It should give tied values their average rank",
+			[]*big.Float{bu.StrToFloat("20"), bu.StrToFloat("10"), bu.StrToFloat("30"), bu.StrToFloat("20")},
+			[]string{"2.5", "1", "4", "2.5"},
+			[]int64{2},
+		},
+		{
+			"It should handle several groups of ties",
+			[]*big.Float{bu.StrToFloat("5"), bu.StrToFloat("5"), bu.StrToFloat("5"), bu.StrToFloat("1"), bu.StrToFloat("1"), bu.StrToFloat("9")},
+			[]string{"4", "4", "4", "1.5", "1.5", "6"},
+			[]int64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Ranks(tt.values)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for i := range tt.want {
+				if compare := bu.NewCompare(got[i], tt.want[i]); !compare.Equal() {
+					t.Errorf("Ranks()[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+			if ties := TieCounts(tt.values); !slices.Equal(ties, tt.ties) {
+				t.Errorf("TieCounts() = %v, want %v", ties, tt.ties)
+			}
+		})
+	}
+	if _, err := Ranks([]int{}); err == nil {
+		t.Errorf("Ranks() expected an error for no values")
+	}
+}
@@ -0,0 +1,358 @@
+package hypothesis
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/descriptive"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Below this many observations (per sample, for Mann–Whitney) and without ties, rank statistics get their exact
+// null distribution, as in R
+const exactRankLimit = 50
+
+// RankTestOptions picks the tail, "two" by default, and the hypothesised location Mu (0 by default): the median of x
+// for the sign and signed-rank tests, or the shift of x against y for Mann–Whitney. For paired data, test the
+// differences x[i] - y[i]
+type RankTestOptions struct {
+	Tail string
+	Mu   *big.Float
+}
+
+// RankTestResult holds the statistic and its p-value. Exact is false when the p-value comes from the normal
+// approximation, and then Z is the normal score it used, with a continuity correction of half a unit towards the
+// null mean. N counts the observations the test used, after dropping any that equal Mu for the one-sample tests.
+// DegreesOfFreedom is only set for Kruskal–Wallis
+type RankTestResult struct {
+	Statistic        *big.Float
+	Z                *big.Float
+	PValue           *big.Float
+	Exact            bool
+	N                int64
+	DegreesOfFreedom int64
+}
+
+// SignTest counts the observations above Mu, ignoring those equal to it. Under the null that count is Binomial(n, 1/2),
+// so the p-value is BinomialPValue with p = 0.5 and the statistic is the count
+func SignTest[T op.Number | op.BigNumber](x []T, options ...RankTestOptions) (result RankTestResult, err error) {
+	option, err := resolveRankOptions(options)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	differences, err := shifted(x, option.Mu)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	above := int64(0)
+	for _, difference := range differences {
+		if difference.Sign() == 1 {
+			above++
+		}
+		if difference.Sign() != 0 {
+			result.N++
+		}
+	}
+	if result.N == 0 {
+		return RankTestResult{}, errors.New("sign test needs at least one observation different from mu")
+	}
+	pValue, err := calculator.BinomialPValue(bu.StrToFloat("0.5"), result.N, above, option.Tail)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	result.Statistic, result.PValue, result.Exact = bu.PrecFloat().SetInt64(above), &pValue, true
+	return result, nil
+}
+
+// WilcoxonSignedRank ranks |x - Mu| over the observations not equal to Mu, with ties sharing their average rank, and
+// takes V, the sum of the ranks of the positive differences. Under the null V has mean n(n + 1)/4 and variance
+// n(n + 1)(2n + 1)/24 - Σ (t³ - t)/48 over the groups of t tied |x - Mu|
+func WilcoxonSignedRank[T op.Number | op.BigNumber](x []T, options ...RankTestOptions) (result RankTestResult, err error) {
+	option, err := resolveRankOptions(options)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	shiftedX, err := shifted(x, option.Mu)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	differences, magnitudes := []*big.Float{}, []*big.Float{}
+	for _, difference := range shiftedX {
+		if difference.Sign() != 0 {
+			differences = append(differences, difference)
+			magnitudes = append(magnitudes, bu.PrecFloat().Abs(difference))
+		}
+	}
+	n := int64(len(differences))
+	if n == 0 {
+		return RankTestResult{}, errors.New("signed-rank test needs at least one observation different from mu")
+	}
+	ranks, err := descriptive.Ranks(magnitudes)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	statistic := bu.PrecFloat().SetInt64(0)
+	for i, difference := range differences {
+		if difference.Sign() == 1 {
+			statistic.Add(statistic, ranks[i])
+		}
+	}
+	result = RankTestResult{Statistic: statistic, N: n}
+	ties := descriptive.TieCounts(magnitudes)
+	if len(ties) == 0 && n < exactRankLimit {
+		result.Exact = true
+		result.PValue, err = exactPValue(signedRankDistribution(n), statistic, option.Tail)
+		return result, err
+	}
+	mean := bu.PrecFloat().SetInt64(n * (n + 1))
+	mean.Quo(mean, bu.StrToFloat("4"))
+	variance := bu.PrecFloat().SetInt64(n * (n + 1) * (2*n + 1))
+	variance.Quo(variance, bu.StrToFloat("24"))
+	variance.Sub(variance, bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(tieCorrection(ties)), bu.StrToFloat("48")))
+	result.Z, result.PValue, err = normalApproximation(statistic, mean, variance, option.Tail)
+	return result, err
+}
+
+// MannWhitneyU ranks x - Mu and y together, with ties sharing their average rank, and takes U = R_x - n_x(n_x + 1)/2
+// from the rank sum of x, the number of pairs where x - Mu beats y (ties counting half). Under the null U has mean
+// n_x n_y / 2 and variance n_x n_y / 12 · ((N + 1) - Σ (t³ - t) / (N(N - 1))) for N = n_x + n_y
+func MannWhitneyU[T op.Number | op.BigNumber](x, y []T, options ...RankTestOptions) (result RankTestResult, err error) {
+	option, err := resolveRankOptions(options)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	if len(x) == 0 || len(y) == 0 {
+		return RankTestResult{}, errors.New("rank-sum test needs at least one observation in each sample")
+	}
+	combined, err := shifted(x, option.Mu)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	ys, err := shifted(y, nil)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	combined = append(combined, ys...)
+	ranks, err := descriptive.Ranks(combined)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	nx, ny := int64(len(x)), int64(len(y))
+	statistic := bu.PrecFloat().SetInt64(-nx * (nx + 1) / 2)
+	for _, rank := range ranks[:nx] {
+		statistic.Add(statistic, rank)
+	}
+	result = RankTestResult{Statistic: statistic, N: nx + ny}
+	ties := descriptive.TieCounts(combined)
+	if len(ties) == 0 && nx < exactRankLimit && ny < exactRankLimit {
+		result.Exact = true
+		result.PValue, err = exactPValue(rankSumDistribution(nx, ny), statistic, option.Tail)
+		return result, err
+	}
+	total := nx + ny
+	mean := bu.PrecFloat().SetInt64(nx * ny)
+	mean.Quo(mean, bu.StrToFloat("2"))
+	adjustment := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(tieCorrection(ties)), bu.PrecFloat().SetInt64(total*(total-1)))
+	variance := bu.PrecFloat().Sub(bu.PrecFloat().SetInt64(total+1), adjustment)
+	variance.Mul(variance, bu.PrecFloat().SetInt64(nx*ny))
+	variance.Quo(variance, bu.StrToFloat("12"))
+	result.Z, result.PValue, err = normalApproximation(statistic, mean, variance, option.Tail)
+	return result, err
+}
+
+// KruskalWallis ranks every observation together and takes H = 12 / (N(N + 1)) Σ R_i² / n_i - 3(N + 1) over the rank
+// sums R_i of the groups, divided by 1 - Σ (t³ - t) / (N³ - N) for ties. The p-value is the right tail of chi-square
+// with k - 1 degrees of freedom
+func KruskalWallis[T op.Number | op.BigNumber](groups [][]T) (result RankTestResult, err error) {
+	if len(groups) < 2 {
+		return RankTestResult{}, errors.New("kruskal-wallis test needs at least two groups")
+	}
+	combined := []*big.Float{}
+	for _, group := range groups {
+		if len(group) == 0 {
+			return RankTestResult{}, errors.New("kruskal-wallis test needs at least one observation in every group")
+		}
+		floats, err := shifted(group, nil)
+		if err != nil {
+			return RankTestResult{}, err
+		}
+		combined = append(combined, floats...)
+	}
+	ranks, err := descriptive.Ranks(combined)
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	n := int64(len(combined))
+	ties := descriptive.TieCounts(combined)
+	correction := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(tieCorrection(ties)), bu.PrecFloat().SetInt64(n*n*n-n))
+	correction.Sub(bu.StrToFloat("1"), correction)
+	if correction.Sign() == 0 {
+		return RankTestResult{}, errors.New("kruskal-wallis statistic is undefined when every observation is the same")
+	}
+	statistic := bu.PrecFloat().SetInt64(0)
+	start := 0
+	for _, group := range groups {
+		sum := bu.PrecFloat().SetInt64(0)
+		for _, rank := range ranks[start : start+len(group)] {
+			sum.Add(sum, rank)
+		}
+		start += len(group)
+		statistic.Add(statistic, bu.PrecFloat().Quo(sum.Mul(sum, sum), bu.PrecFloat().SetInt64(int64(len(group)))))
+	}
+	statistic.Mul(statistic, bu.PrecFloat().Quo(bu.StrToFloat("12"), bu.PrecFloat().SetInt64(n*(n+1))))
+	statistic.Sub(statistic, bu.PrecFloat().SetInt64(3*(n+1)))
+	statistic.Quo(statistic, correction)
+	result = RankTestResult{Statistic: statistic, N: n, DegreesOfFreedom: int64(len(groups) - 1)}
+	pValue, err := calculator.ChiSquarePValue(statistic, bu.PrecFloat().SetInt64(result.DegreesOfFreedom), "right")
+	if err != nil {
+		return RankTestResult{}, err
+	}
+	result.PValue = &pValue
+	return result, nil
+}
+
+func resolveRankOptions(options []RankTestOptions) (RankTestOptions, error) {
+	option := RankTestOptions{Tail: "two", Mu: bu.PrecFloat().SetInt64(0)}
+	if len(options) > 0 {
+		if options[0].Tail != "" {
+			option.Tail = options[0].Tail
+		}
+		if options[0].Mu != nil {
+			option.Mu = options[0].Mu
+		}
+	}
+	if err := calculator.ValidateTail(option.Tail); err != nil {
+		return RankTestOptions{}, err
+	}
+	return option, nil
+}
+
+// shifted converts values to big.Float, subtracting mu unless it is nil
+func shifted[T op.Number | op.BigNumber](values []T, mu *big.Float) ([]*big.Float, error) {
+	floats, err := op.ToBigFloats(values)
+	if err != nil {
+		return nil, err
+	}
+	if mu != nil {
+		for _, float := range floats {
+			float.Sub(float, mu)
+		}
+	}
+	return floats, nil
+}
+
+// tieCorrection returns Σ (t³ - t) over the groups of ties
+func tieCorrection(ties []int64) int64 {
+	total := int64(0)
+	for _, t := range ties {
+		total += t*t*t - t
+	}
+	return total
+}
+
+// signedRankDistribution counts the subsets of the ranks 1..n with each sum, the coefficients of Π (1 + q^i)
+func signedRankDistribution(n int64) []*big.Int {
+	counts := []*big.Int{big.NewInt(1)}
+	for i := int64(1); i <= n; i++ {
+		next := make([]*big.Int, len(counts)+int(i))
+		for s := range next {
+			next[s] = new(big.Int)
+			if s < len(counts) {
+				next[s].Add(next[s], counts[s])
+			}
+			if s >= int(i) && s-int(i) < len(counts) {
+				next[s].Add(next[s], counts[s-int(i)])
+			}
+		}
+		counts = next
+	}
+	return counts
+}
+
+// rankSumDistribution counts the orderings of m x values among n y values by U, the coefficients of the Gaussian
+// binomial coefficient Π_(i=1..m) (1 - q^(n+i)) / (1 - q^i). Each factor is applied in place and every division
+// is exact
+func rankSumDistribution(m, n int64) []*big.Int {
+	counts := make([]*big.Int, m*n+1)
+	for u := range counts {
+		counts[u] = new(big.Int)
+	}
+	counts[0].SetInt64(1)
+	for i := int64(1); i <= m; i++ {
+		// Multiplying by 1 - q^(n+i) runs from the top so each coefficient still reads its old neighbour
+		for u := int64(len(counts)) - 1; u >= n+i; u-- {
+			counts[u].Sub(counts[u], counts[u-n-i])
+		}
+		// Dividing by 1 - q^i runs from the bottom so each coefficient reads its new neighbour
+		for u := i; u < int64(len(counts)); u++ {
+			counts[u].Add(counts[u], counts[u-i])
+		}
+	}
+	return counts
+}
+
+// exactPValue reads the tails off a null distribution given as counts by statistic value: P(S <= s) on the left,
+// P(S >= s) on the right and twice the smaller, capped at 1, for both
+func exactPValue(counts []*big.Int, statistic *big.Float, tail string) (*big.Float, error) {
+	s, accuracy := statistic.Int64()
+	if accuracy != big.Exact {
+		return nil, errors.New("exact rank distribution needs a whole-number statistic")
+	}
+	total, below, above := new(big.Int), new(big.Int), new(big.Int)
+	for value, count := range counts {
+		total.Add(total, count)
+		if int64(value) <= s {
+			below.Add(below, count)
+		}
+		if int64(value) >= s {
+			above.Add(above, count)
+		}
+	}
+	totalFloat := bu.PrecFloat().SetInt(total)
+	left := bu.PrecFloat().Quo(bu.PrecFloat().SetInt(below), totalFloat)
+	right := bu.PrecFloat().Quo(bu.PrecFloat().SetInt(above), totalFloat)
+	switch tail {
+	case "left":
+		return left, nil
+	case "right":
+		return right, nil
+	}
+	two := bu.PrecFloat().Mul(bu.StrToFloat("2"), left)
+	if right.Cmp(left) < 0 {
+		two.Mul(bu.StrToFloat("2"), right)
+	}
+	if two.Cmp(bu.StrToFloat("1")) > 0 {
+		two.SetInt64(1)
+	}
+	return two, nil
+}
+
+// normalApproximation scores the statistic against its null mean and variance. The continuity correction moves it
+// half a unit towards the mean: down for the right tail, up for the left, and towards the mean for both
+func normalApproximation(statistic, mean, variance *big.Float, tail string) (z, pValue *big.Float, err error) {
+	if variance.Sign() != 1 {
+		return nil, nil, errors.New("rank statistic has no variance, so the normal approximation is undefined")
+	}
+	deviation := bu.PrecFloat().Sub(statistic, mean)
+	half := bu.StrToFloat("0.5")
+	switch tail {
+	case "left":
+		deviation.Add(deviation, half)
+	case "right":
+		deviation.Sub(deviation, half)
+	default:
+		if deviation.Sign() == 1 {
+			deviation.Sub(deviation, half)
+		} else if deviation.Sign() == -1 {
+			deviation.Add(deviation, half)
+		}
+	}
+	z = deviation.Quo(deviation, bu.PrecFloat().Sqrt(variance))
+	p, err := calculator.NormalPValue(z, bu.PrecFloat().SetInt64(0), bu.StrToFloat("1"), tail)
+	if err != nil {
+		return nil, nil, err
+	}
+	return z, &p, nil
+}
@@ -0,0 +1,190 @@
+package hypothesis
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_SignTest(t *testing.T) {
+	x := []*big.Float{bu.StrToFloat("1.5"), bu.StrToFloat("-0.3"), bu.StrToFloat("2.1"), bu.StrToFloat("0.8"), bu.StrToFloat("0"), bu.StrToFloat("1.1"), bu.StrToFloat("-1.4"), bu.StrToFloat("2.6"), bu.StrToFloat("0.9"), bu.StrToFloat("1.7")}
+	tests := []struct {
+		tail string
+		want string
+	}{
+		{"two", "0.1796875"},
+		{"right", "0.08984375"},
+		{"left", "0.98046875"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tail, func(t *testing.T) {
+			got, err := SignTest(x, RankTestOptions{Tail: tt.tail})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.N != 9 || !got.Exact {
+				t.Errorf("SignTest() n = %d, exact = %v, want 9 and true", got.N, got.Exact)
+			}
+			if compare := bu.NewCompare(got.Statistic, "7"); !compare.Equal() {
+				t.Errorf("SignTest() statistic = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(got.PValue, tt.want); !compare.Equal() {
+				t.Errorf("SignTest() p-value = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_WilcoxonSignedRank(t *testing.T) {
+	x := []*big.Float{bu.StrToFloat("1.5"), bu.StrToFloat("-0.3"), bu.StrToFloat("2.1"), bu.StrToFloat("0.8"), bu.StrToFloat("0"), bu.StrToFloat("1.1"), bu.StrToFloat("-1.4"), bu.StrToFloat("2.6"), bu.StrToFloat("0.9"), bu.StrToFloat("1.7")}
+	ties := []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("-2"), bu.StrToFloat("3"), bu.StrToFloat("3"), bu.StrToFloat("-1"), bu.StrToFloat("4"), bu.StrToFloat("5"), bu.StrToFloat("2"), bu.StrToFloat("0"), bu.StrToFloat("6")}
+	tests := []struct {
+		name    string
+		x       []*big.Float
+		options RankTestOptions
+		exact   bool
+		// statistic, z, p-value
+		want []string
+	}{
+		{"It should use the exact distribution without ties", x, RankTestOptions{}, true, []string{"39", "", "0.0546875"}},
+		{"It should give the exact right tail", x, RankTestOptions{Tail: "right"}, true, []string{"39", "", "0.02734375"}},
+		{
+			"It should fall back to the normal approximation when shifting by mu creates ties", x, RankTestOptions{Mu: bu.StrToFloat("1")}, false,
+			[]string{"26.5", "-0.050997845386547666052367485707", "0.959327237448362241707193571021"},
+		},
+		{
+			"It should use the normal approximation with ties", ties, RankTestOptions{}, false,
+			[]string{"49.5", "2.200071393415598786227447154847", "0.027801830120072504569821205955"},
+		},
+		{
+			"It should correct the left tail towards the mean", ties, RankTestOptions{Tail: "left"}, false,
+			[]string{"49.5", "2.302400295434928962331049348096", "0.989343695833089837231484399638"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := WilcoxonSignedRank(tt.x, tt.options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Exact != tt.exact {
+				t.Errorf("WilcoxonSignedRank() exact = %v, want %v", got.Exact, tt.exact)
+			}
+			compareRankResult(t, got, tt.want)
+		})
+	}
+}
+
+func Test_MannWhitneyU(t *testing.T) {
+	x, y := []*big.Float{bu.StrToFloat("1.1"), bu.StrToFloat("2.3"), bu.StrToFloat("3.5"), bu.StrToFloat("4.2"), bu.StrToFloat("5.9")}, []*big.Float{bu.StrToFloat("0.4"), bu.StrToFloat("1.7"), bu.StrToFloat("2.0"), bu.StrToFloat("2.8"), bu.StrToFloat("3.1"), bu.StrToFloat("0.9")}
+	tiedX, tiedY := []int{1, 2, 2, 3, 5, 6}, []int{2, 3, 4, 4, 7, 8, 9}
+	tests := []struct {
+		name  string
+		test  func() (RankTestResult, error)
+		exact bool
+		want  []string
+	}{
+		{"It should use the exact distribution without ties", func() (RankTestResult, error) { return MannWhitneyU(x, y) }, true, []string{"24", "", "0.125541125541125541125541125541"}},
+		{"It should give the exact left tail", func() (RankTestResult, error) { return MannWhitneyU(x, y, RankTestOptions{Tail: "left"}) }, true, []string{"24", "", "0.958874458874458874458874458874"}},
+		{
+			"It should use the normal approximation with ties", func() (RankTestResult, error) { return MannWhitneyU(tiedX, tiedY) }, false,
+			[]string{"10.5", "-1.440492954552338024715603994198", "0.149727981895451292885424835052"},
+		},
+		{
+			"It should correct the right tail towards the mean", func() (RankTestResult, error) { return MannWhitneyU(tiedX, tiedY, RankTestOptions{Tail: "right"}) }, false,
+			[]string{"10.5", "-1.584542250007571827187164393618", "0.943464812521007709320215731042"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.test()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Exact != tt.exact {
+				t.Errorf("MannWhitneyU() exact = %v, want %v", got.Exact, tt.exact)
+			}
+			compareRankResult(t, got, tt.want)
+		})
+	}
+}
+
+func Test_KruskalWallis(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups [][]*big.Float
+		want   []string
+	}{
+		{
+			"It should test groups without ties",
+			[][]*big.Float{[]*big.Float{bu.StrToFloat("2.9"), bu.StrToFloat("3.0"), bu.StrToFloat("2.5"), bu.StrToFloat("2.6"), bu.StrToFloat("3.2")}, []*big.Float{bu.StrToFloat("3.8"), bu.StrToFloat("2.7"), bu.StrToFloat("4.0"), bu.StrToFloat("2.4")}, []*big.Float{bu.StrToFloat("2.8"), bu.StrToFloat("3.4"), bu.StrToFloat("3.7"), bu.StrToFloat("2.2"), bu.StrToFloat("2.0")}},
+			[]string{"0.771428571428571428571428571429", "0.679964773578893819319794927039"},
+		},
+		{
+			"It should correct for ties",
+			[][]*big.Float{[]*big.Float{bu.StrToFloat("1"), bu.StrToFloat("2"), bu.StrToFloat("2"), bu.StrToFloat("3")}, []*big.Float{bu.StrToFloat("3"), bu.StrToFloat("4"), bu.StrToFloat("5")}, []*big.Float{bu.StrToFloat("5"), bu.StrToFloat("5"), bu.StrToFloat("6"), bu.StrToFloat("7")}},
+			[]string{"8.214563862928348909657320872274", "0.016452432646396920591259318181"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := KruskalWallis(tt.groups)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.DegreesOfFreedom != 2 {
+				t.Errorf("KruskalWallis() degrees of freedom = %d, want 2", got.DegreesOfFreedom)
+			}
+			if compare := bu.NewCompare(got.Statistic, tt.want[0]); !compare.Equal() {
+				t.Errorf("KruskalWallis() statistic = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+			if compare := bu.NewCompare(got.PValue, tt.want[1]); !compare.Equal() {
+				t.Errorf("KruskalWallis() p-value = %v, want %v", compare.ActualAsString, compare.Expected)
+			}
+		})
+	}
+}
+
+func Test_RankTestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		test func() (RankTestResult, error)
+	}{
+		{"It should reject a sign test where every value equals mu", func() (RankTestResult, error) { return SignTest([]int{0, 0}) }},
+		{"It should reject an unknown tail", func() (RankTestResult, error) { return SignTest([]int{1, 2}, RankTestOptions{Tail: "up"}) }},
+		{"It should reject a signed-rank test with no data", func() (RankTestResult, error) { return WilcoxonSignedRank([]int{}) }},
+		{"It should reject an empty sample", func() (RankTestResult, error) { return MannWhitneyU([]int{1, 2}, []int{}) }},
+		{"It should reject a single group", func() (RankTestResult, error) { return KruskalWallis([][]int{{1, 2, 3}}) }},
+		{"It should reject an empty group", func() (RankTestResult, error) { return KruskalWallis([][]int{{1, 2}, {}}) }},
+		{"It should reject NaN", func() (RankTestResult, error) { return WilcoxonSignedRank([]float64{1, math.NaN(), 2}) }},
+		{"It should reject an infinite observation", func() (RankTestResult, error) {
+			return MannWhitneyU([]float64{1, 2}, []float64{math.Inf(1), 3})
+		}},
+		{"It should reject identical observations", func() (RankTestResult, error) { return KruskalWallis([][]int{{4, 4}, {4}}) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.test(); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+// compareRankResult checks the statistic, z and p-value, skipping any wanted as ""; z must be nil for an exact test
+func compareRankResult(t *testing.T, got RankTestResult, want []string) {
+	t.Helper()
+	if got.Exact && got.Z != nil {
+		t.Errorf("exact test reported z = %v", got.Z)
+	}
+	for i, value := range []*big.Float{got.Statistic, got.Z, got.PValue} {
+		if want[i] == "" {
+			continue
+		}
+		if compare := bu.NewCompare(value, want[i]); !compare.Equal() {
+			t.Errorf("result[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+		}
+	}
+}
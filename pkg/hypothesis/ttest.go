@@ -0,0 +1,201 @@
+package hypothesis
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/descriptive"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// TTestOptions sets the hypothesised mean, or mean difference, Mu (0 by default) and the confidence level of the
+// interval (0.95 by default)
+type TTestOptions struct {
+	Mu         *big.Float
+	Confidence *big.Float
+}
+
+// TTestResult holds t = (Estimate - Mu) / StandardError with its p-value for each tail. Estimate is the mean of x for
+// a one-sample test, the mean of x - y for a paired test and the difference of the means x̄ - ȳ for a two-sample test,
+// and Lower and Upper are the two-sided confidence interval around it. CohensD is (Estimate - Mu) over the standard
+// deviation the test is built on: of x, of the differences, the pooled one, or for Welch the root mean of the two
+// variances. DegreesOfFreedom is a whole number except for Welch
+type TTestResult struct {
+	Statistic        *big.Float
+	DegreesOfFreedom *big.Float
+	LeftPValue       *big.Float
+	RightPValue      *big.Float
+	TwoPValue        *big.Float
+	Estimate         *big.Float
+	StandardError    *big.Float
+	Lower, Upper     *big.Float
+	CohensD          *big.Float
+}
+
+// PValue picks the p-value for "left", "right" or "two"
+func (result TTestResult) PValue(tail string) (*big.Float, error) {
+	if err := calculator.ValidateTail(tail); err != nil {
+		return nil, err
+	}
+	switch tail {
+	case "left":
+		return result.LeftPValue, nil
+	case "right":
+		return result.RightPValue, nil
+	}
+	return result.TwoPValue, nil
+}
+
+// OneSampleTTest tests whether the mean of x is Mu, with t = (x̄ - μ) / (s / √n) on n - 1 degrees of freedom
+func OneSampleTTest[T op.Number | op.BigNumber](x []T, options ...TTestOptions) (result TTestResult, err error) {
+	if len(x) < 2 {
+		return TTestResult{}, errors.New("t-test needs at least two observations")
+	}
+	mean, variance, err := meanAndVariance(x)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	n := bu.PrecFloat().SetInt64(int64(len(x)))
+	standardError := bu.PrecFloat().Sqrt(bu.PrecFloat().Quo(variance, n))
+	df := n.Sub(n, bu.StrToFloat("1"))
+	return tTest(mean, standardError, df, bu.PrecFloat().Sqrt(variance), options)
+}
+
+// PairedTTest is the one-sample test on the differences x[i] - y[i]
+func PairedTTest[T op.Number | op.BigNumber](x, y []T, options ...TTestOptions) (result TTestResult, err error) {
+	if len(x) != len(y) {
+		return TTestResult{}, errors.New("paired t-test needs the same number of x and y observations")
+	}
+	xs, err := op.ToBigFloats(x)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	ys, err := op.ToBigFloats(y)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	differences := make([]*big.Float, len(x))
+	for i := range xs {
+		differences[i] = bu.PrecFloat().Sub(xs[i], ys[i])
+	}
+	return OneSampleTTest(differences, options...)
+}
+
+// PooledTTest is Student's two-sample test, which assumes x and y share a variance. It estimates it by
+// s_p² = ((n_x - 1) s_x² + (n_y - 1) s_y²) / (n_x + n_y - 2), giving t = (x̄ - ȳ - μ) / (s_p √(1/n_x + 1/n_y)) on
+// n_x + n_y - 2 degrees of freedom
+func PooledTTest[T op.Number | op.BigNumber](x, y []T, options ...TTestOptions) (result TTestResult, err error) {
+	xMean, xVariance, yMean, yVariance, err := twoSamples(x, y)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	one := bu.StrToFloat("1")
+	nx, ny := bu.PrecFloat().SetInt64(int64(len(x))), bu.PrecFloat().SetInt64(int64(len(y)))
+	df := bu.PrecFloat().Sub(bu.PrecFloat().Add(nx, ny), bu.StrToFloat("2"))
+	pooled := bu.PrecFloat().Mul(bu.PrecFloat().Sub(nx, one), xVariance)
+	pooled.Add(pooled, bu.PrecFloat().Mul(bu.PrecFloat().Sub(ny, one), yVariance))
+	pooled.Quo(pooled, df)
+	scale := bu.PrecFloat().Add(bu.PrecFloat().Quo(one, nx), bu.PrecFloat().Quo(one, ny))
+	standardError := bu.PrecFloat().Sqrt(scale.Mul(scale, pooled))
+	return tTest(bu.PrecFloat().Sub(xMean, yMean), standardError, df, bu.PrecFloat().Sqrt(pooled), options)
+}
+
+// WelchTTest drops the equal-variance assumption: t = (x̄ - ȳ - μ) / √(s_x²/n_x + s_y²/n_y), with the
+// Welch–Satterthwaite degrees of freedom (a + b)² / (a²/(n_x - 1) + b²/(n_y - 1)) for a = s_x²/n_x and b = s_y²/n_y
+func WelchTTest[T op.Number | op.BigNumber](x, y []T, options ...TTestOptions) (result TTestResult, err error) {
+	xMean, xVariance, yMean, yVariance, err := twoSamples(x, y)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	one := bu.StrToFloat("1")
+	nx, ny := bu.PrecFloat().SetInt64(int64(len(x))), bu.PrecFloat().SetInt64(int64(len(y)))
+	a, b := bu.PrecFloat().Quo(xVariance, nx), bu.PrecFloat().Quo(yVariance, ny)
+	total := bu.PrecFloat().Add(a, b)
+	// Two constant samples would make the degrees of freedom 0/0
+	if total.Sign() == 0 {
+		return TTestResult{}, errors.New("t statistic is undefined when the data has no variance")
+	}
+	df := bu.PrecFloat().Mul(total, total)
+	xShare := bu.PrecFloat().Quo(bu.PrecFloat().Mul(a, a), bu.PrecFloat().Sub(nx, one))
+	yShare := bu.PrecFloat().Quo(bu.PrecFloat().Mul(b, b), bu.PrecFloat().Sub(ny, one))
+	df.Quo(df, xShare.Add(xShare, yShare))
+	average := bu.PrecFloat().Quo(bu.PrecFloat().Add(xVariance, yVariance), bu.StrToFloat("2"))
+	return tTest(bu.PrecFloat().Sub(xMean, yMean), bu.PrecFloat().Sqrt(total), df, bu.PrecFloat().Sqrt(average), options)
+}
+
+func meanAndVariance[T op.Number | op.BigNumber](values []T) (mean, variance *big.Float, err error) {
+	if mean, err = descriptive.Mean(values); err != nil {
+		return nil, nil, err
+	}
+	if variance, err = descriptive.SampleVariance(values); err != nil {
+		return nil, nil, err
+	}
+	return mean, variance, nil
+}
+
+func twoSamples[T op.Number | op.BigNumber](x, y []T) (xMean, xVariance, yMean, yVariance *big.Float, err error) {
+	if len(x) < 2 || len(y) < 2 {
+		return nil, nil, nil, nil, errors.New("two-sample t-test needs at least two observations in each sample")
+	}
+	if xMean, xVariance, err = meanAndVariance(x); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if yMean, yVariance, err = meanAndVariance(y); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return xMean, xVariance, yMean, yVariance, nil
+}
+
+// tTest finishes every variant from its estimate, standard error, degrees of freedom and the standard deviation
+// behind Cohen's d. The interval is Estimate ± t_(1 - α/2) · StandardError
+func tTest(estimate, standardError, df, deviation *big.Float, options []TTestOptions) (result TTestResult, err error) {
+	mu, confidence := bu.PrecFloat().SetInt64(0), bu.StrToFloat("0.95")
+	if len(options) > 0 {
+		if options[0].Mu != nil {
+			mu = options[0].Mu
+		}
+		if options[0].Confidence != nil {
+			confidence = options[0].Confidence
+		}
+	}
+	one := bu.StrToFloat("1")
+	if confidence.Sign() != 1 || confidence.Cmp(one) >= 0 {
+		return TTestResult{}, errors.New("t-test confidence level must be strictly between 0 and 1")
+	}
+	if standardError.Sign() == 0 {
+		return TTestResult{}, errors.New("t statistic is undefined when the data has no variance")
+	}
+	result = TTestResult{DegreesOfFreedom: df, Estimate: estimate, StandardError: standardError}
+	shift := bu.PrecFloat().Sub(estimate, mu)
+	result.Statistic = bu.PrecFloat().Quo(shift, standardError)
+	result.CohensD = bu.PrecFloat().Quo(shift, deviation)
+
+	left, err := calculator.StudentTPValue(result.Statistic, df, "left")
+	if err != nil {
+		return TTestResult{}, err
+	}
+	right, err := calculator.StudentTPValue(result.Statistic, df, "right")
+	if err != nil {
+		return TTestResult{}, err
+	}
+	result.LeftPValue, result.RightPValue = &left, &right
+	result.TwoPValue = bu.PrecFloat().Mul(bu.StrToFloat("2"), &left)
+	if right.Cmp(&left) < 0 {
+		result.TwoPValue.Mul(bu.StrToFloat("2"), &right)
+	}
+	if result.TwoPValue.Cmp(one) > 0 {
+		result.TwoPValue.Set(one)
+	}
+
+	probability := bu.PrecFloat().Quo(bu.PrecFloat().Add(one, confidence), bu.StrToFloat("2"))
+	critical, err := calculator.StudentTQuantile(probability, df)
+	if err != nil {
+		return TTestResult{}, err
+	}
+	margin := bu.PrecFloat().Mul(&critical, standardError)
+	result.Lower = bu.PrecFloat().Sub(estimate, margin)
+	result.Upper = bu.PrecFloat().Add(estimate, margin)
+	return result, nil
+}
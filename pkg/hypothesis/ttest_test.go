@@ -0,0 +1,111 @@
+package hypothesis
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_TTests(t *testing.T) {
+	x := []*big.Float{bu.StrToFloat("5.1"), bu.StrToFloat("4.9"), bu.StrToFloat("5.6"), bu.StrToFloat("5.8"), bu.StrToFloat("6.0"), bu.StrToFloat("5.4"), bu.StrToFloat("5.3"), bu.StrToFloat("6.1")}
+	y := []*big.Float{bu.StrToFloat("4.8"), bu.StrToFloat("4.7"), bu.StrToFloat("5.3"), bu.StrToFloat("5.9"), bu.StrToFloat("5.5"), bu.StrToFloat("5.0"), bu.StrToFloat("4.9"), bu.StrToFloat("5.6")}
+	z := []*big.Float{bu.StrToFloat("4.2"), bu.StrToFloat("4.9"), bu.StrToFloat("5.1"), bu.StrToFloat("4.6"), bu.StrToFloat("5.3"), bu.StrToFloat("4.4")}
+	tests := []struct {
+		name string
+		test func() (TTestResult, error)
+		// statistic, df, left, right, two, estimate, standard error, lower, upper, Cohen's d
+		want []string
+	}{
+		{
+			"It should run a one-sample test against Mu",
+			func() (TTestResult, error) { return OneSampleTTest(x, TTestOptions{Mu: bu.StrToFloat("5")}) },
+			[]string{
+				"3.479350852233958953233713508568", "7", "0.994862367436623562248912617582", "0.005137632563376437751087382418",
+				"0.010275265126752875502174764836", "5.525", "0.150890215530554342359625330729", "5.168201337028388862613488857797",
+				"5.881798662971611137386511142203", "1.230136290870912831156716892033",
+			},
+		},
+		{
+			"It should run a paired test on the differences",
+			func() (TTestResult, error) { return PairedTTest(x, y) },
+			[]string{
+				"4.510968544481586781506431073119", "7", "0.998619090080336610809107627895", "0.001380909919663389190892372105",
+				"0.002761819839326778381784744211", "0.3125", "0.069275588361681511287420191480", "0.148689263716608985954311127785",
+				"0.476310736283391014045688872215", "1.594868223761070078733141496640",
+			},
+		},
+		{
+			"It should run a pooled two-sample test",
+			func() (TTestResult, error) { return PooledTTest(x, z) },
+			[]string{
+				"3.374574803147919008436443335205", "12", "0.997238357546165204597116689576", "0.002761642453834795402883310424",
+				"0.005523284907669590805766620848", "0.775", "0.229658562992011154740813504757", "0.274616976510066648912314413950",
+				"1.275383023489933351087685586050", "1.822478688881867674217142334177",
+			},
+		},
+		{
+			"It should run a Welch test with fractional degrees of freedom",
+			func() (TTestResult, error) { return WelchTTest(x, z, TTestOptions{Confidence: bu.StrToFloat("0.9")}) },
+			[]string{
+				"3.379122342534589786583441122256", "10.977125774284810156550957768953", "0.996915254596720544939742534821",
+				"0.003084745403279455060257465179", "0.006169490806558910120514930357", "0.775", "0.229349494170339246882011284158",
+				"0.363036169796973041643021620131", "1.186963830203026958356978379869", "1.823799891518748135807770686873",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.test()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			values := []*big.Float{
+				got.Statistic, got.DegreesOfFreedom, got.LeftPValue, got.RightPValue, got.TwoPValue,
+				got.Estimate, got.StandardError, got.Lower, got.Upper, got.CohensD,
+			}
+			for i, value := range values {
+				if compare := bu.NewCompare(value, tt.want[i]); !compare.Equal() {
+					t.Errorf("result[%d] = %v, want %v", i, compare.ActualAsString, compare.Expected)
+				}
+			}
+			for _, tail := range []string{"left", "right", "two"} {
+				if _, err := got.PValue(tail); err != nil {
+					t.Errorf("PValue(%q) unexpected error: %v", tail, err)
+				}
+			}
+			if _, err := got.PValue("both"); err == nil {
+				t.Errorf("PValue() expected an error for an unknown tail")
+			}
+		})
+	}
+}
+
+func Test_TTestErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		test func() (TTestResult, error)
+	}{
+		{"It should reject a single observation", func() (TTestResult, error) { return OneSampleTTest([]int{4}) }},
+		{"It should reject data with no variance", func() (TTestResult, error) { return OneSampleTTest([]int{4, 4, 4}) }},
+		{"It should reject unpaired samples", func() (TTestResult, error) { return PairedTTest([]int{1, 2, 3}, []int{1, 2}) }},
+		{"It should reject a sample of one", func() (TTestResult, error) { return WelchTTest([]int{1, 2, 3}, []int{1}) }},
+		{"It should reject two constant samples", func() (TTestResult, error) { return PooledTTest([]int{1, 1}, []int{2, 2}) }},
+		{"It should reject two constant samples without pooling", func() (TTestResult, error) { return WelchTTest([]int{1, 1, 1}, []int{2, 2, 2}) }},
+		{"It should reject NaN", func() (TTestResult, error) { return OneSampleTTest([]float64{1, math.NaN(), 3}) }},
+		{"It should reject an infinite difference", func() (TTestResult, error) {
+			return PairedTTest([]float64{1, 2, 3}, []float64{1, math.Inf(-1), 2})
+		}},
+		{"It should reject a confidence level of 1", func() (TTestResult, error) {
+			return OneSampleTTest([]int{1, 2, 4}, TTestOptions{Confidence: bu.StrToFloat("1")})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.test(); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
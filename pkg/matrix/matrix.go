@@ -302,15 +302,25 @@ func (nonZeroDiagonalMatrix *Matrix[T, U]) UpperTriangularize() (triangularizedM
 		rowReducer = floatTriangularRowReducer[T, U]
 	}
 	lenRows := len(rows)
-	operandRows := make([][]op.Operand[T], lenRows)
-	operandRows[0] = rows[0]
+	operandRows := rows
 	valueRows := make([][]T, lenRows)
-	for rowIndex, row := range operandRows {
-		for nextRowIndex := rowIndex + 1; nextRowIndex < lenRows; nextRowIndex++ {
-			if lenRows > nextRowIndex {
-				nextRow := rows[nextRowIndex]
-				nextRow, scale = rowReducer(rowIndex, row, nextRow, scale)
-				operandRows[nextRowIndex] = nextRow
+	for rowIndex := range operandRows {
+		// Matching rows to a nonzero diagonal up front does not stop elimination from zeroing a later pivot, so swap
+		// in a row from below that still has a value in this column. Each swap flips the sign of the determinant
+		if operandRows[rowIndex][rowIndex].Cmp(zero) == 0 {
+			for swapIndex := rowIndex + 1; swapIndex < lenRows; swapIndex++ {
+				if operandRows[swapIndex][rowIndex].Cmp(zero) != 0 {
+					operandRows[rowIndex], operandRows[swapIndex] = operandRows[swapIndex], operandRows[rowIndex]
+					scale = scale.Mul(scale.Negation())
+					break
+				}
+			}
+		}
+		row := operandRows[rowIndex]
+		// With no row left to swap in, everything below the pivot is already zero
+		if row[rowIndex].Cmp(zero) != 0 {
+			for nextRowIndex := rowIndex + 1; nextRowIndex < lenRows; nextRowIndex++ {
+				operandRows[nextRowIndex], scale = rowReducer(rowIndex, row, operandRows[nextRowIndex], scale)
 			}
 		}
 		valueRow := su.Map(row, func(value op.Operand[T]) T {
@@ -1078,6 +1078,30 @@ func Test_Determinant(t *testing.T) {
 			expected: -306, // Determinant calculated manually
 			wantErr:  false,
 		},
+		{
+			name: "Determinant when elimination zeroes a pivot",
+			matrix: getAssumedNoErrorMatrix(NewNumberMatrix(
+				[][]int{
+					{1, 1, 1},
+					{1, 1, 2},
+					{1, 2, 1},
+				},
+			)),
+			expected: -1, // Rows 2 and 3 swap once the first column is cleared
+			wantErr:  false,
+		},
+		{
+			name: "Determinant of singular matrix with a nonzero diagonal",
+			matrix: getAssumedNoErrorMatrix(NewNumberMatrix(
+				[][]int{
+					{1, 2, 3},
+					{2, 4, 6},
+					{1, 1, 1},
+				},
+			)),
+			expected: 0,
+			wantErr:  false,
+		},
 		{
 			name: "Determinant of 1x1 matrix",
 			matrix: getAssumedNoErrorMatrix(NewNumberMatrix(
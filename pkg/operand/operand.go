@@ -1,7 +1,9 @@
 package operand
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"math/big"
 
 	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
@@ -276,3 +278,39 @@ func ToFloat[T Number | BigNumber, U FloatNumber](operand Operand[T]) Operand[U]
 	}
 	return Operand[U]{Value: value}
 }
+
+// ToBigFloat converts value to a *big.Float at the working precision of big_utils.PrecFloat. NaN and ±Inf are
+// rejected here, since big.Float arithmetic panics on NaN and on Inf - Inf
+func ToBigFloat[T Number | BigNumber](value T) (*big.Float, error) {
+	float := bu.PrecFloat()
+	switch v := any(value).(type) {
+	case int:
+		float.SetInt64(int64(v))
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.New("values must be finite numbers, not NaN or ±Inf")
+		}
+		float.SetFloat64(v)
+	case *big.Int:
+		float.SetInt(v)
+	case *big.Float:
+		if v.IsInf() {
+			return nil, errors.New("values must be finite numbers, not NaN or ±Inf")
+		}
+		float.Set(v)
+	}
+	return float, nil
+}
+
+// ToBigFloats converts every value with ToBigFloat, failing on the first one that is not finite
+func ToBigFloats[T Number | BigNumber](values []T) ([]*big.Float, error) {
+	floats := make([]*big.Float, len(values))
+	for i, value := range values {
+		float, err := ToBigFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		floats[i] = float
+	}
+	return floats, nil
+}
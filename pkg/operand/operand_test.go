@@ -1,9 +1,12 @@
 package operand
 
 import (
+	"math"
 	"math/big"
 	"reflect"
 	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
 )
 
 func Test_NewOperand(t *testing.T) {
@@ -731,3 +734,81 @@ func Test_Operand_Cmp(t *testing.T) {
 		})
 	}
 }
+
+func Test_ToBigFloat(t *testing.T) {
+	type testCase[T Number | BigNumber] struct {
+		name     string
+		value    T
+		expected string
+		wantErr  bool
+	}
+
+	intTests := []testCase[int]{
+		{name: "Convert int", value: -7, expected: "-7"},
+	}
+	float64Tests := []testCase[float64]{
+		{name: "Convert float64", value: 0.25, expected: "0.25"},
+		{name: "Reject NaN", value: math.NaN(), wantErr: true},
+		{name: "Reject +Inf", value: math.Inf(1), wantErr: true},
+		{name: "Reject -Inf", value: math.Inf(-1), wantErr: true},
+	}
+	bigIntTests := []testCase[*big.Int]{
+		{name: "Convert *big.Int", value: big.NewInt(42), expected: "42"},
+	}
+	bigFloatTests := []testCase[*big.Float]{
+		{name: "Convert *big.Float", value: big.NewFloat(1.5), expected: "1.5"},
+		{name: "Reject an infinite *big.Float", value: new(big.Float).SetInf(true), wantErr: true},
+	}
+
+	check := func(t *testing.T, got *big.Float, err error, expected string, wantErr bool) {
+		if (err != nil) != wantErr {
+			t.Fatalf("ToBigFloat() error = %v, wantErr %v", err, wantErr)
+		}
+		if wantErr {
+			return
+		}
+		if got.Prec() != bu.PrecFloat().Prec() {
+			t.Errorf("ToBigFloat() precision = %d, want %d", got.Prec(), bu.PrecFloat().Prec())
+		}
+		if got.Text('g', 10) != expected {
+			t.Errorf("ToBigFloat() = %v, want %v", got.Text('g', 10), expected)
+		}
+	}
+	for _, tt := range intTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToBigFloat(tt.value)
+			check(t, got, err, tt.expected, tt.wantErr)
+		})
+	}
+	for _, tt := range float64Tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToBigFloat(tt.value)
+			check(t, got, err, tt.expected, tt.wantErr)
+		})
+	}
+	for _, tt := range bigIntTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToBigFloat(tt.value)
+			check(t, got, err, tt.expected, tt.wantErr)
+		})
+	}
+	for _, tt := range bigFloatTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToBigFloat(tt.value)
+			check(t, got, err, tt.expected, tt.wantErr)
+		})
+	}
+}
+
+func Test_ToBigFloats(t *testing.T) {
+	floats, err := ToBigFloats([]float64{1, 2.5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(floats) != 2 || floats[1].Text('g', 10) != "2.5" {
+		t.Errorf("ToBigFloats() = %v, want [1 2.5]", floats)
+	}
+	if _, err := ToBigFloats([]float64{1, math.NaN()}); err == nil {
+		t.Errorf("ToBigFloats() expected an error for NaN")
+	}
+}
@@ -0,0 +1,219 @@
+package regression
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+const defaultCurveIterations = 100
+
+// Gauss-Newton stops once a step moves both parameters by less than this fraction of their size
+var curveTolerance = bu.StrToFloat("1e-30")
+
+// CurveFitOptions turns on a nonlinear least-squares refinement of the log-linear fit, capped at MaxIterations
+// Gauss-Newton steps (100 when left at zero)
+type CurveFitOptions struct {
+	Refine        bool
+	MaxIterations int
+}
+
+// CurveFit is one set of parameters for y = a·e^(bx) or y = a·x^b, judged in the original scale of y
+type CurveFit struct {
+	A, B      *big.Float
+	SSE       *big.Float
+	RSquared  *big.Float
+	Fitted    []*big.Float
+	Residuals []*big.Float
+}
+
+// CurveFitResult holds the fit found by regressing ln y, and the linear fit behind it with its own log-scale
+// statistics. Refined is nil unless refinement was asked for; it minimises the squared residuals of y itself rather
+// than of ln y, which weights large values of y more heavily
+type CurveFitResult struct {
+	Linearised CurveFit
+	LogScale   LinearFit
+	Refined    *CurveFit
+	Iterations int
+	Converged  bool
+}
+
+// Exponential fits y = a·e^(bx), starting from ln y = ln a + bx. Every y must be positive
+func Exponential[T op.Number | op.BigNumber](x, y []T, options ...CurveFitOptions) (result CurveFitResult, err error) {
+	if len(x) != len(y) {
+		return CurveFitResult{}, errors.New("regression needs the same number of x and y observations")
+	}
+	u, err := op.ToBigFloats(x)
+	if err != nil {
+		return CurveFitResult{}, err
+	}
+	return exponentialFit(u, y, options)
+}
+
+// Power fits y = a·x^b, starting from ln y = ln a + b ln x. Every x and y must be positive. Since x^b = e^(b ln x),
+// this is the exponential fit against ln x
+func Power[T op.Number | op.BigNumber](x, y []T, options ...CurveFitOptions) (result CurveFitResult, err error) {
+	if len(x) != len(y) {
+		return CurveFitResult{}, errors.New("regression needs the same number of x and y observations")
+	}
+	u := make([]*big.Float, len(x))
+	for i, value := range x {
+		float, err := op.ToBigFloat(value)
+		if err != nil {
+			return CurveFitResult{}, err
+		}
+		if float.Sign() != 1 {
+			return CurveFitResult{}, errors.New("power regression needs every x to be positive")
+		}
+		if u[i], err = calculator.Ln(float); err != nil {
+			return CurveFitResult{}, err
+		}
+	}
+	return exponentialFit(u, y, options)
+}
+
+func exponentialFit[T op.Number | op.BigNumber](u []*big.Float, y []T, options []CurveFitOptions) (result CurveFitResult, err error) {
+	var option CurveFitOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	response, err := op.ToBigFloats(y)
+	if err != nil {
+		return CurveFitResult{}, err
+	}
+	logResponse := make([]*big.Float, len(y))
+	for i := range response {
+		if response[i].Sign() != 1 {
+			return CurveFitResult{}, errors.New("exponential and power regression need every y to be positive")
+		}
+		if logResponse[i], err = calculator.Ln(response[i]); err != nil {
+			return CurveFitResult{}, err
+		}
+	}
+	result.LogScale, err = SimpleLinear(u, logResponse)
+	if err != nil {
+		return CurveFitResult{}, err
+	}
+	a := calculator.Exp(result.LogScale.Coefficients[0])
+	b := bu.PrecFloat().Set(result.LogScale.Coefficients[1])
+	result.Linearised = evaluateCurve(u, response, a, b)
+	if !option.Refine {
+		return result, nil
+	}
+	maxIterations := option.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = defaultCurveIterations
+	}
+	refined, iterations, converged, err := refineCurve(u, response, result.Linearised, maxIterations)
+	if err != nil {
+		return CurveFitResult{}, err
+	}
+	result.Refined, result.Iterations, result.Converged = &refined, iterations, converged
+	return result, nil
+}
+
+func evaluateCurve(u, y []*big.Float, a, b *big.Float) CurveFit {
+	fit := CurveFit{A: a, B: b, SSE: bu.PrecFloat().SetInt64(0), Fitted: make([]*big.Float, len(u)), Residuals: make([]*big.Float, len(u))}
+	mean := bu.PrecFloat().SetInt64(0)
+	for i := range u {
+		fit.Fitted[i] = bu.PrecFloat().Mul(a, calculator.Exp(bu.PrecFloat().Mul(b, u[i])))
+		fit.Residuals[i] = bu.PrecFloat().Sub(y[i], fit.Fitted[i])
+		fit.SSE.Add(fit.SSE, bu.PrecFloat().Mul(fit.Residuals[i], fit.Residuals[i]))
+		mean.Add(mean, y[i])
+	}
+	mean.Quo(mean, bu.PrecFloat().SetInt64(int64(len(y))))
+	sst := bu.PrecFloat().SetInt64(0)
+	for _, value := range y {
+		deviation := bu.PrecFloat().Sub(value, mean)
+		sst.Add(sst, deviation.Mul(deviation, deviation))
+	}
+	if sst.Sign() == 1 {
+		fit.RSquared = bu.PrecFloat().Sub(bu.StrToFloat("1"), bu.PrecFloat().Quo(fit.SSE, sst))
+	}
+	return fit
+}
+
+// refineCurve runs Gauss-Newton on Σ (y - a·e^(bu))², with Jacobian rows [e^(bu), a·u·e^(bu)] and the step solving
+// (J^T J) δ = J^T r. A step that would raise the sum of squares is halved until it does not
+func refineCurve(u, y []*big.Float, start CurveFit, maxIterations int) (fit CurveFit, iterations int, converged bool, err error) {
+	fit = start
+	for iterations < maxIterations {
+		iterations++
+		jacobian := make([][]*big.Float, len(u))
+		residuals := make([][]*big.Float, len(u))
+		for i := range u {
+			growth := calculator.Exp(bu.PrecFloat().Mul(fit.B, u[i]))
+			jacobian[i] = []*big.Float{growth, bu.PrecFloat().Mul(fit.Fitted[i], u[i])}
+			residuals[i] = []*big.Float{fit.Residuals[i]}
+		}
+		step, err := gaussNewtonStep(jacobian, residuals)
+		if err != nil {
+			return CurveFit{}, iterations, false, err
+		}
+		scale := bu.StrToFloat("1")
+		var candidate CurveFit
+		improved := false
+		for range 60 {
+			a := bu.PrecFloat().Add(fit.A, bu.PrecFloat().Mul(step[0], scale))
+			b := bu.PrecFloat().Add(fit.B, bu.PrecFloat().Mul(step[1], scale))
+			candidate = evaluateCurve(u, y, a, b)
+			if candidate.SSE.Cmp(fit.SSE) <= 0 {
+				improved = true
+				break
+			}
+			scale.Quo(scale, bu.StrToFloat("2"))
+		}
+		// No step along the Gauss-Newton direction helps, so this is as close to the minimum as the arithmetic gets
+		if !improved {
+			return fit, iterations, true, nil
+		}
+		small := isSmallStep(bu.PrecFloat().Sub(candidate.A, fit.A), candidate.A) && isSmallStep(bu.PrecFloat().Sub(candidate.B, fit.B), candidate.B)
+		fit = candidate
+		if small {
+			return fit, iterations, true, nil
+		}
+	}
+	return fit, iterations, false, nil
+}
+
+func gaussNewtonStep(jacobian, residuals [][]*big.Float) ([]*big.Float, error) {
+	j, err := matrix.NewBigMatrix(jacobian)
+	if err != nil {
+		return nil, err
+	}
+	r, err := matrix.NewBigMatrix(residuals)
+	if err != nil {
+		return nil, err
+	}
+	transpose := j.Transpose()
+	normal, err := transpose.Mul(j)
+	if err != nil {
+		return nil, err
+	}
+	inverse, isSingular, err := normal.Inverse()
+	if isSingular {
+		return nil, errors.New("curve refinement reached a point where the parameters cannot be separated")
+	}
+	if err != nil {
+		return nil, err
+	}
+	gradient, err := transpose.Mul(r)
+	if err != nil {
+		return nil, err
+	}
+	step, err := inverse.Mul(gradient)
+	if err != nil {
+		return nil, err
+	}
+	return step.GetColumn(0)
+}
+
+func isSmallStep(step, value *big.Float) bool {
+	limit := bu.PrecFloat().Abs(value)
+	limit.Mul(limit, curveTolerance)
+	return bu.PrecFloat().Abs(step).Cmp(limit) <= 0
+}
@@ -0,0 +1,82 @@
+package regression
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Exponential(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6}
+	y := []float64{3.125, 6.875, 15.25, 33.75, 76.125, 168}
+	result, err := Exponential(x, y, CurveFitOptions{Refine: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Converged {
+		t.Errorf("Exponential() did not converge in %d iterations", result.Iterations)
+	}
+	tests := []struct {
+		name string
+		fit  *CurveFit
+		want []string
+	}{
+		{"log-linear", &result.Linearised, []string{"1.39768965608706488633713201544", "0.79801441836491048668888478453", "0.43039177179392643886907332215", "0.99997862314920789093650512455"}},
+		{"refined", result.Refined, []string{"1.40415480892510733823636300268", "0.79751886079312487656209478633", "0.31709382351055243708082455353", "0.99998425047178752756033068302"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			compareAll(t, "a, b, SSE, R²", []*big.Float{tt.fit.A, tt.fit.B, tt.fit.SSE, tt.fit.RSquared}, tt.want)
+		})
+	}
+	if compare := bu.NewCompare(result.LogScale.Coefficients[1], "0.79801441836491048668888478453"); !compare.Equal() {
+		t.Errorf("Exponential() log-scale slope = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_Power(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6}
+	y := []float64{2.875, 8.125, 15.25, 23.875, 34.75, 47.25}
+	result, err := Power(x, y, CurveFitOptions{Refine: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Converged {
+		t.Errorf("Power() did not converge in %d iterations", result.Iterations)
+	}
+	compareAll(t, "log-linear", []*big.Float{result.Linearised.A, result.Linearised.B, result.Linearised.SSE, result.Linearised.RSquared},
+		[]string{"2.80898351976763403737994137267", "1.55870411394564770190883909524", "2.35986951312775783268831554802", "0.99832333919910696925146619134"})
+	compareAll(t, "refined", []*big.Float{result.Refined.A, result.Refined.B, result.Refined.SSE, result.Refined.RSquared},
+		[]string{"2.52747673498284225707662070532", "1.63150685104030764548946889639", "0.44704247940592265668039956010", "0.99968238133617798798429288155"})
+}
+
+func Test_CurveFitWithoutRefinement(t *testing.T) {
+	result, err := Exponential([]int{0, 1, 2}, []int{2, 6, 18})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Refined != nil {
+		t.Errorf("Exponential() refined without being asked to")
+	}
+	compareAll(t, "a, SSE", []*big.Float{result.Linearised.A, result.Linearised.SSE}, []string{"2", "0"})
+}
+
+func Test_CurveFitErrors(t *testing.T) {
+	if _, err := Exponential([]int{1, 2, 3}, []int{1, 0, 3}); err == nil {
+		t.Errorf("Exponential() expected an error for a y of zero")
+	}
+	if _, err := Power([]int{1, -2, 3}, []int{1, 2, 3}); err == nil {
+		t.Errorf("Power() expected an error for a negative x")
+	}
+	if _, err := Power([]int{1, 2}, []int{1, 2, 3}); err == nil {
+		t.Errorf("Power() expected an error for mismatched observations")
+	}
+	if _, err := Exponential([]float64{1, math.NaN(), 3}, []float64{1, 2, 3}); err == nil {
+		t.Errorf("Exponential() expected an error for a NaN x")
+	}
+	if _, err := Power([]float64{1, 2, 3}, []float64{1, math.Inf(1), 3}); err == nil {
+		t.Errorf("Power() expected an error for an infinite y")
+	}
+}
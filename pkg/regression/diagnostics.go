@@ -0,0 +1,126 @@
+package regression
+
+import (
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+)
+
+// Past this condition number of the normal equations, rounding the data to the 16 significant digits of a float64 can
+// already shift the coefficients by around a tenth of their size, whatever precision the solve itself uses
+var illConditionedLimit = bu.StrToFloat("1e15")
+
+// Rounding data to a float64 moves each column by up to 2^-53 of its size, which moves the normal equations by about
+// 2^-106. Once the column-scaled condition number passes 1/2^-106 ≈ 8e31 that is enough to make them singular, so the
+// predictors are collinear as far as the data can tell, however precisely the solve is carried out
+var collinearLimit = bu.StrToFloat("1e32")
+
+// ResidualDiagnostics describes how each observation sits in a fit.
+// Leverage is the diagonal h_ii of the hat matrix, how far the observation's predictors pull the fit towards it.
+// StandardizedResiduals are e_i / (σ √(1 - h_ii)) and CooksDistance is e_i² h_ii / (p σ² (1 - h_ii)²), the change in
+// the fitted values when the observation is left out. Both are nil for an exact fit, and an entry is nil where
+// h_ii = 1. DurbinWatson is Σ (e_i - e_(i-1))² / Σ e_i², near 2 when neighbouring residuals are uncorrelated and nil
+// for an exact fit
+type ResidualDiagnostics struct {
+	Leverage              []*big.Float
+	StandardizedResiduals []*big.Float
+	CooksDistance         []*big.Float
+	DurbinWatson          *big.Float
+}
+
+// residualDiagnostics takes the weighted design matrix √W X, the inverse of its normal matrix and the weighted
+// residuals e = √W (y - ŷ), so that h_ii = x_i^T (X^T W X)^-1 x_i
+func residualDiagnostics(x *matrix.BigMatrix[*big.Float], inverse *matrix.BigMatrix[*big.Float], residuals []*big.Float, sigma *big.Float, exact bool) (diagnostics ResidualDiagnostics, err error) {
+	projected, err := x.Mul(inverse)
+	if err != nil {
+		return ResidualDiagnostics{}, err
+	}
+	rows := x.GetRows()
+	projectedRows := projected.GetRows()
+	p := bu.PrecFloat().SetInt64(int64(len(rows[0])))
+	one := bu.StrToFloat("1")
+	diagnostics.Leverage = make([]*big.Float, len(rows))
+	for i, row := range rows {
+		leverage := bu.PrecFloat().SetInt64(0)
+		for j, value := range row {
+			leverage.Add(leverage, bu.PrecFloat().Mul(value, projectedRows[i][j]))
+		}
+		diagnostics.Leverage[i] = leverage
+	}
+	if exact {
+		return diagnostics, nil
+	}
+
+	diagnostics.StandardizedResiduals = make([]*big.Float, len(rows))
+	diagnostics.CooksDistance = make([]*big.Float, len(rows))
+	for i, leverage := range diagnostics.Leverage {
+		remaining := bu.PrecFloat().Sub(one, leverage)
+		if remaining.Cmp(exactFitTolerance) <= 0 {
+			continue
+		}
+		standardized := bu.PrecFloat().Quo(residuals[i], bu.PrecFloat().Mul(sigma, bu.PrecFloat().Sqrt(remaining)))
+		diagnostics.StandardizedResiduals[i] = standardized
+		cook := bu.PrecFloat().Mul(standardized, standardized)
+		cook.Mul(cook, leverage)
+		diagnostics.CooksDistance[i] = cook.Quo(cook, bu.PrecFloat().Mul(p, remaining))
+	}
+
+	differences := bu.PrecFloat().SetInt64(0)
+	squares := bu.PrecFloat().SetInt64(0)
+	for i, residual := range residuals {
+		squares.Add(squares, bu.PrecFloat().Mul(residual, residual))
+		if i > 0 {
+			difference := bu.PrecFloat().Sub(residual, residuals[i-1])
+			differences.Add(differences, difference.Mul(difference, difference))
+		}
+	}
+	diagnostics.DurbinWatson = differences.Quo(differences, squares)
+	return diagnostics, nil
+}
+
+// conditionNumber returns the 1-norm condition number ||A||_1 ||A^-1||_1, the largest absolute column sum of each
+func conditionNumber(normal, inverse *matrix.BigMatrix[*big.Float]) *big.Float {
+	return bu.PrecFloat().Mul(columnSumNorm(normal), columnSumNorm(inverse))
+}
+
+func columnSumNorm(m *matrix.BigMatrix[*big.Float]) *big.Float {
+	norm := bu.PrecFloat().SetInt64(0)
+	for _, column := range m.GetColumns() {
+		sum := bu.PrecFloat().SetInt64(0)
+		for _, value := range column {
+			sum.Add(sum, bu.PrecFloat().Abs(value))
+		}
+		if sum.Cmp(norm) > 0 {
+			norm = sum
+		}
+	}
+	return norm
+}
+
+// scaledConditionNumber is the condition number of the normal matrix A after scaling it to a unit diagonal,
+// D^-1 A D^-1 with D = diag(√a_ii), whose inverse is D A^-1 D. Scaling takes out differences in the size of the
+// predictors, such as x against x³, and leaves only how close they are to collinear
+func scaledConditionNumber(normal, inverse *matrix.BigMatrix[*big.Float]) *big.Float {
+	rows, inverseRows := normal.GetRows(), inverse.GetRows()
+	scale := make([]*big.Float, len(rows))
+	for i := range rows {
+		scale[i] = bu.PrecFloat().Sqrt(rows[i][i])
+	}
+	normalNorm, inverseNorm := bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(0)
+	for j := range rows {
+		normalSum, inverseSum := bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(0)
+		for i := range rows {
+			product := bu.PrecFloat().Mul(scale[i], scale[j])
+			normalSum.Add(normalSum, bu.PrecFloat().Quo(bu.PrecFloat().Abs(rows[i][j]), product))
+			inverseSum.Add(inverseSum, product.Mul(product, bu.PrecFloat().Abs(inverseRows[i][j])))
+		}
+		if normalSum.Cmp(normalNorm) > 0 {
+			normalNorm = normalSum
+		}
+		if inverseSum.Cmp(inverseNorm) > 0 {
+			inverseNorm = inverseSum
+		}
+	}
+	return normalNorm.Mul(normalNorm, inverseNorm)
+}
@@ -0,0 +1,69 @@
+package regression
+
+import (
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+)
+
+func Test_ResidualDiagnostics(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	y := []float64{1.5, 4.25, 9.5, 16, 26.25, 35.5, 49.75, 64}
+	fit, err := Polynomial(x, y, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	diagnostics := fit.Diagnostics
+	compareAll(t, "leverage", diagnostics.Leverage[:3], []string{"0.708333333333333333333333333333", "0.279761904761904761904761904762", "0.232142857142857142857142857143"})
+	compareAll(t, "standardized residuals", diagnostics.StandardizedResiduals[:3], []string{"0.341588551724495279634264618964", "-0.313358092904289058176887377728", "0.139439540434279491326237032624"})
+	compareAll(t, "Cook's distance", diagnostics.CooksDistance[:3], []string{"0.094457455113192818110850897736", "0.012713732331308026093089925868", "0.001959410935463564709324490863"})
+	if compare := bu.NewCompare(diagnostics.DurbinWatson, "3.670748955319832851173256187721"); !compare.Equal() {
+		t.Errorf("Durbin-Watson = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	leverage := bu.PrecFloat().SetInt64(0)
+	for _, value := range diagnostics.Leverage {
+		leverage.Add(leverage, value)
+	}
+	if compare := bu.NewCompare(leverage, "3.000000000000000000000000000000"); !compare.Equal() {
+		t.Errorf("leverage should sum to the number of coefficients, got %v", compare.ActualAsString)
+	}
+}
+
+func Test_ResidualDiagnosticsExactFit(t *testing.T) {
+	fit, err := SimpleLinear([]int{1, 2, 3, 4}, []int{3, 5, 7, 9})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fit.Diagnostics.Leverage) != 4 {
+		t.Errorf("leverage should be reported for an exact fit")
+	}
+	if fit.Diagnostics.StandardizedResiduals != nil || fit.Diagnostics.CooksDistance != nil || fit.Diagnostics.DurbinWatson != nil {
+		t.Errorf("residual diagnostics should be undefined for an exact fit")
+	}
+}
+
+func Test_ScaledConditionNumber(t *testing.T) {
+	design := make([][]*big.Float, 8)
+	for i := range design {
+		x := bu.PrecFloat().SetInt64(int64(i + 1))
+		design[i] = []*big.Float{bu.PrecFloat().SetInt64(1), x, bu.PrecFloat().Mul(x, x)}
+	}
+	x, err := matrix.NewBigMatrix(design)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	normal, err := x.Transpose().Mul(x)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	inverse, _, err := normal.Inverse()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Scaling to a unit diagonal takes the quadratic on 1..8 from 30265.7 down to this
+	if compare := bu.NewCompare(scaledConditionNumber(normal, inverse), "605.361527100604888601772934264516"); !compare.Equal() {
+		t.Errorf("scaledConditionNumber() = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
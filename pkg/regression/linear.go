@@ -0,0 +1,244 @@
+package regression
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// LinearFit is a least-squares fit. Coefficients start with the intercept, followed by one slope per predictor in the
+// order they were given. TStatistics and PValues test each coefficient against zero, two-tailed, and are nil for an
+// exact fit; RSquared and AdjustedRSquared are nil when y never varies. For a weighted fit the residuals are y minus
+// the fitted values, while R², the residual standard error and the diagnostics all weight each residual by √w.
+// Warnings explains anything that makes the fit less trustworthy than its numbers suggest
+type LinearFit struct {
+	Coefficients          []*big.Float
+	StandardErrors        []*big.Float
+	TStatistics           []*big.Float
+	PValues               []*big.Float
+	Covariance            *matrix.BigMatrix[*big.Float]
+	RSquared              *big.Float
+	AdjustedRSquared      *big.Float
+	ResidualStandardError *big.Float
+	DegreesOfFreedom      int64
+	Fitted                []*big.Float
+	Residuals             []*big.Float
+	Diagnostics           ResidualDiagnostics
+	ConditionNumber       *big.Float
+	Warnings              []string
+}
+
+// LeastSquaresOptions weights each observation in the sum of squares, for weighted least squares. Weights must be
+// positive; leaving them out weights every observation equally
+type LeastSquaresOptions struct {
+	Weights []*big.Float
+}
+
+// SimpleLinear fits y = b0 + b1 x
+func SimpleLinear[T op.Number | op.BigNumber](x, y []T, options ...LeastSquaresOptions) (fit LinearFit, err error) {
+	rows := make([][]T, len(x))
+	for i, value := range x {
+		rows[i] = []T{value}
+	}
+	return MultipleLinear(rows, y, options...)
+}
+
+// MultipleLinear fits y = b0 + b1 x_1 + ... + b_k x_k, where x[i] holds the k predictors for observation i
+func MultipleLinear[T op.Number | op.BigNumber](x [][]T, y []T, options ...LeastSquaresOptions) (fit LinearFit, err error) {
+	if len(x) != len(y) {
+		return LinearFit{}, errors.New("regression needs the same number of x and y observations")
+	}
+	design, err := designMatrix(x)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	response, err := op.ToBigFloats(y)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	return leastSquares(design, response, options)
+}
+
+// designMatrix prepends the intercept column of ones to the predictors
+func designMatrix[T op.Number | op.BigNumber](x [][]T) ([][]*big.Float, error) {
+	if len(x) == 0 || len(x[0]) == 0 {
+		return nil, errors.New("regression needs at least one predictor")
+	}
+	design := make([][]*big.Float, len(x))
+	for i, row := range x {
+		if len(row) != len(x[0]) {
+			return nil, errors.New("regression observations must all have the same number of predictors")
+		}
+		predictors, err := op.ToBigFloats(row)
+		if err != nil {
+			return nil, err
+		}
+		design[i] = append([]*big.Float{bu.PrecFloat().SetInt64(1)}, predictors...)
+	}
+	return design, nil
+}
+
+// leastSquares fits a design matrix whose first column is the intercept. With W the diagonal matrix of weights it
+// solves the normal equations (X^T W X) β = X^T W y, which is ordinary least squares on √W X and √W y.
+// With σ² = Σ w (y - ŷ)² / (n - p), the covariance of β is σ² (X^T W X)^-1. Predictors that are collinear to within
+// float64 rounding are an error, and a large but resolvable condition number is a warning
+func leastSquares(design [][]*big.Float, y []*big.Float, options []LeastSquaresOptions) (fit LinearFit, err error) {
+	if len(design) == 0 {
+		return LinearFit{}, errors.New("regression needs at least one observation")
+	}
+	n, p := len(design), len(design[0])
+	if n <= p {
+		return LinearFit{}, errors.New("regression needs more observations than coefficients")
+	}
+	weights, err := regressionWeights(n, options)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	roots := make([]*big.Float, n)
+	weightedDesign := make([][]*big.Float, n)
+	weightedY := make([][]*big.Float, n)
+	for i := range design {
+		roots[i] = bu.PrecFloat().Sqrt(weights[i])
+		weightedDesign[i] = make([]*big.Float, p)
+		for j, value := range design[i] {
+			weightedDesign[i][j] = bu.PrecFloat().Mul(roots[i], value)
+		}
+		weightedY[i] = []*big.Float{bu.PrecFloat().Mul(roots[i], y[i])}
+	}
+	x, err := matrix.NewBigMatrix(weightedDesign)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	yMatrix, err := matrix.NewBigMatrix(weightedY)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	transpose := x.Transpose()
+	normal, err := transpose.Mul(x)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	inverse, isSingular, err := normal.Inverse()
+	if err != nil && !isSingular {
+		return LinearFit{}, err
+	}
+	if isSingular || scaledConditionNumber(normal, inverse).Cmp(collinearLimit) > 0 {
+		return LinearFit{}, errors.New("regression predictors are collinear, so the coefficients are not unique")
+	}
+	projection, err := transpose.Mul(yMatrix)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	beta, err := inverse.Mul(projection)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	fit.Coefficients, _ = beta.GetColumn(0)
+
+	fit.Fitted = make([]*big.Float, n)
+	fit.Residuals = make([]*big.Float, n)
+	weightedResiduals := make([]*big.Float, n)
+	sse := bu.PrecFloat().SetInt64(0)
+	for i := range y {
+		fit.Fitted[i] = bu.PrecFloat().SetInt64(0)
+		for j, value := range design[i] {
+			fit.Fitted[i].Add(fit.Fitted[i], bu.PrecFloat().Mul(value, fit.Coefficients[j]))
+		}
+		fit.Residuals[i] = bu.PrecFloat().Sub(y[i], fit.Fitted[i])
+		weightedResiduals[i] = bu.PrecFloat().Mul(roots[i], fit.Residuals[i])
+		sse.Add(sse, bu.PrecFloat().Mul(weightedResiduals[i], weightedResiduals[i]))
+	}
+	fit.DegreesOfFreedom = int64(n - p)
+	df := bu.PrecFloat().SetInt64(fit.DegreesOfFreedom)
+	variance := bu.PrecFloat().Quo(sse, df)
+	fit.ResidualStandardError = bu.PrecFloat().Sqrt(variance)
+
+	fit.Covariance = inverse.ScalarMul(variance)
+	covarianceRows := fit.Covariance.GetRows()
+	fit.StandardErrors = make([]*big.Float, p)
+	for j := range p {
+		fit.StandardErrors[j] = bu.PrecFloat().Sqrt(covarianceRows[j][j])
+	}
+	exact := isExactFit(sse, weightedY)
+	if !exact {
+		fit.TStatistics = make([]*big.Float, p)
+		fit.PValues = make([]*big.Float, p)
+		for j := range p {
+			fit.TStatistics[j] = bu.PrecFloat().Quo(fit.Coefficients[j], fit.StandardErrors[j])
+			pValue, err := calculator.StudentTPValue(fit.TStatistics[j], df, "two")
+			if err != nil {
+				return LinearFit{}, err
+			}
+			fit.PValues[j] = &pValue
+		}
+	}
+
+	// R² = 1 - SSE / SST and adjusted R² = 1 - (SSE / (n - p)) / (SST / (n - 1)), about the weighted mean of y
+	mean := bu.PrecFloat().SetInt64(0)
+	totalWeight := bu.PrecFloat().SetInt64(0)
+	for i, value := range y {
+		mean.Add(mean, bu.PrecFloat().Mul(weights[i], value))
+		totalWeight.Add(totalWeight, weights[i])
+	}
+	mean.Quo(mean, totalWeight)
+	sst := bu.PrecFloat().SetInt64(0)
+	for i, value := range y {
+		deviation := bu.PrecFloat().Sub(value, mean)
+		deviation.Mul(deviation, deviation)
+		sst.Add(sst, deviation.Mul(deviation, weights[i]))
+	}
+	if sst.Sign() == 1 {
+		one := bu.StrToFloat("1")
+		fit.RSquared = bu.PrecFloat().Sub(one, bu.PrecFloat().Quo(sse, sst))
+		adjusted := bu.PrecFloat().Quo(variance, bu.PrecFloat().Quo(sst, bu.PrecFloat().SetInt64(int64(n-1))))
+		fit.AdjustedRSquared = adjusted.Sub(one, adjusted)
+	}
+
+	fit.Diagnostics, err = residualDiagnostics(x, inverse, weightedResiduals, fit.ResidualStandardError, exact)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	fit.ConditionNumber = conditionNumber(normal, inverse)
+	if fit.ConditionNumber.Cmp(illConditionedLimit) > 0 {
+		fit.Warnings = append(fit.Warnings, fmt.Sprintf(
+			"the normal equations are ill-conditioned (condition number %s), so small changes in the data can move the coefficients a long way; centring or rescaling x, or fitting fewer terms, should help",
+			fit.ConditionNumber.Text('e', 3)))
+	}
+	return fit, nil
+}
+
+func regressionWeights(n int, options []LeastSquaresOptions) ([]*big.Float, error) {
+	weights := make([]*big.Float, n)
+	if len(options) == 0 || options[0].Weights == nil {
+		for i := range weights {
+			weights[i] = bu.PrecFloat().SetInt64(1)
+		}
+		return weights, nil
+	}
+	if len(options[0].Weights) != n {
+		return nil, errors.New("regression needs one weight per observation")
+	}
+	for i, weight := range options[0].Weights {
+		if weight == nil || weight.Sign() != 1 {
+			return nil, errors.New("regression weights must be positive")
+		}
+		weights[i] = bu.PrecFloat().Set(weight)
+	}
+	return weights, nil
+}
+
+// A residual sum of squares this small relative to Σ y² is rounding error in the solve, not lack of fit
+var exactFitTolerance = bu.StrToFloat("1e-60")
+
+func isExactFit(sse *big.Float, y [][]*big.Float) bool {
+	scale := bu.PrecFloat().SetInt64(0)
+	for _, row := range y {
+		scale.Add(scale, bu.PrecFloat().Mul(row[0], row[0]))
+	}
+	return sse.Cmp(scale.Mul(scale, exactFitTolerance)) <= 0
+}
@@ -0,0 +1,163 @@
+package regression
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func compareAll(t *testing.T, name string, got []*big.Float, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s has %d values, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if compare := bu.NewCompare(got[i], want[i]); !compare.Equal() {
+			t.Errorf("%s[%d] = %v, want %v", name, i, compare.ActualAsString, compare.Expected)
+		}
+	}
+}
+
+func Test_SimpleLinear(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	y := []float64{2.125, 3.875, 6.25, 7.75, 10.125, 12.25, 13.75, 16.125}
+	fit, err := SimpleLinear(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fit.DegreesOfFreedom != 6 {
+		t.Errorf("SimpleLinear() degrees of freedom = %d, want 6", fit.DegreesOfFreedom)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"0.044642857142857142857142857143", "1.997023809523809523809523809524"})
+	compareAll(t, "standard errors", fit.StandardErrors, []string{"0.175481702601284915241814164254", "0.034750555333605147105102141425"})
+	compareAll(t, "t statistics", fit.TStatistics, []string{"0.254401777969358830093123367709", "57.467392689192777841525000467625"})
+	compareAll(t, "p-values", fit.PValues, []string{"0.807679667063344895", "0.0000000018651142690432"})
+	compareAll(t, "residuals", fit.Residuals[:3], []string{"0.083333333333333333333333333333", "-0.16369047619047619047619047619", "0.214285714285714285714285714286"})
+	compareAll(t, "summary", []*big.Float{fit.RSquared, fit.AdjustedRSquared, fit.ResidualStandardError}, []string{
+		"0.998186490015718564533686280509", "0.997884238351671658622633993927", "0.225209338242769213430872158245",
+	})
+}
+
+func Test_MultipleLinear(t *testing.T) {
+	x := [][]int{{1, 3}, {2, 1}, {3, 4}, {4, 1}, {5, 5}, {6, 9}, {7, 2}, {8, 6}}
+	y := []int{5, 6, 10, 9, 14, 19, 15, 20}
+	fit, err := MultipleLinear(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fit.DegreesOfFreedom != 5 {
+		t.Errorf("MultipleLinear() degrees of freedom = %d, want 5", fit.DegreesOfFreedom)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"1.334208223972003499562554680665", "1.740157480314960629921259842520", "0.796150481189851268591426071741"})
+	compareAll(t, "standard errors", fit.StandardErrors, []string{"0.245934855330983057144028104058", "0.053175792561926977238761261730", "0.047392915533802829536977215283"})
+	compareAll(t, "p-values", fit.PValues, []string{"0.0028833369436793", "0.00000050072083359705", "0.0000136630098074269"})
+	compareAll(t, "summary", []*big.Float{fit.RSquared, fit.AdjustedRSquared}, []string{"0.997948802261014912285852411625", "0.997128323165420877200193376275"})
+}
+
+func Test_LinearEdgeCases(t *testing.T) {
+	exact, err := SimpleLinear([]int{1, 2, 3}, []int{3, 5, 7})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	compareAll(t, "coefficients", exact.Coefficients, []string{"1", "2"})
+	if exact.TStatistics != nil || exact.PValues != nil {
+		t.Errorf("SimpleLinear() t statistics should be undefined for an exact fit")
+	}
+	flat, err := SimpleLinear([]int{1, 2, 3}, []int{4, 4, 4})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if flat.RSquared != nil || flat.AdjustedRSquared != nil {
+		t.Errorf("SimpleLinear() R² should be undefined when y never varies")
+	}
+}
+
+func Test_LinearErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		x    [][]int
+		y    []int
+	}{
+		{"It should reject mismatched observations", [][]int{{1}, {2}, {3}}, []int{1, 2}},
+		{"It should reject too few observations", [][]int{{1}, {2}}, []int{1, 2}},
+		{"It should reject ragged predictors", [][]int{{1, 2}, {2}, {3, 1}, {4, 4}}, []int{1, 2, 3, 4}},
+		{"It should reject collinear predictors", [][]int{{1, 2}, {2, 4}, {3, 6}, {4, 8}}, []int{1, 3, 2, 5}},
+		{"It should reject a constant predictor", [][]int{{2}, {2}, {2}}, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := MultipleLinear(tt.x, tt.y); err == nil {
+				t.Errorf("MultipleLinear() expected an error")
+			}
+		})
+	}
+}
+
+func Test_LinearCollinearToFloat64Rounding(t *testing.T) {
+	// x2 is x1 / 10 in decimal, but not quite in binary, so the normal equations are only just invertible
+	x := [][]float64{{1, 0.1}, {2, 0.2}, {3, 0.3}, {4, 0.4}, {5, 0.5}}
+	y := []float64{2.1, 3.9, 6.2, 7.8, 10.1}
+	if _, err := MultipleLinear(x, y); err == nil {
+		t.Errorf("MultipleLinear() expected an error for predictors that are collinear up to rounding")
+	}
+}
+
+func Test_LinearNonFinite(t *testing.T) {
+	tests := []struct {
+		name string
+		x, y []float64
+	}{
+		{"It should reject NaN in x", []float64{1, math.NaN(), 3, 4}, []float64{1, 2, 4, 3}},
+		{"It should reject +Inf in y", []float64{1, 2, 3, 4}, []float64{1, math.Inf(1), 4, 3}},
+		{"It should reject -Inf in y", []float64{1, 2, 3, 4}, []float64{1, 2, math.Inf(-1), 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SimpleLinear(tt.x, tt.y); err == nil {
+				t.Errorf("SimpleLinear() expected an error")
+			}
+		})
+	}
+}
+
+func Test_WeightedLinear(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	y := []float64{1.5, 4.25, 9.5, 16, 26.25, 35.5, 49.75, 64}
+	weights := []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("0.5"), bu.StrToFloat("2"), bu.StrToFloat("1"), bu.StrToFloat("0.25"), bu.StrToFloat("4"), bu.StrToFloat("1"), bu.StrToFloat("2")}
+	fit, err := SimpleLinear(x, y, LeastSquaresOptions{Weights: weights})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"-16.458886324293133294864396999423", "9.266878245816503173687247547605"})
+	covariance := fit.Covariance.GetRows()
+	compareAll(t, "covariance", []*big.Float{covariance[0][0], covariance[0][1], covariance[1][1]}, []string{
+		"22.800270867853358249720371380991", "-3.771355716645613821676464998503", "0.735492608640430911281302302613",
+	})
+	compareAll(t, "summary", []*big.Float{fit.RSquared, fit.AdjustedRSquared}, []string{"0.951123557563438566731747445256", "0.942977483824011661187038686132"})
+	compareAll(t, "leverage", fit.Diagnostics.Leverage[:3], []string{"0.393152529332563954606655125986", "0.130986728216964800923254472014", "0.333910367378341988844008463166"})
+	compareAll(t, "Cook's distance", fit.Diagnostics.CooksDistance[:1], []string{"0.991384106520941141364351024251"})
+	if compare := bu.NewCompare(fit.Diagnostics.DurbinWatson, "0.992792576929988541315318935739"); !compare.Equal() {
+		t.Errorf("Durbin-Watson = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+}
+
+func Test_WeightedLinearErrors(t *testing.T) {
+	x, y := []int{1, 2, 3}, []int{2, 4, 7}
+	tests := []struct {
+		name    string
+		weights []*big.Float
+	}{
+		{"It should reject a missing weight", []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("1")}},
+		{"It should reject a zero weight", []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("0"), bu.StrToFloat("1")}},
+		{"It should reject a negative weight", []*big.Float{bu.StrToFloat("1"), bu.StrToFloat("-1"), bu.StrToFloat("1")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := SimpleLinear(x, y, LeastSquaresOptions{Weights: tt.weights}); err == nil {
+				t.Errorf("SimpleLinear() expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+package regression
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	"github.com/ojsung/basic_stats_calculator/pkg/calculator"
+	"github.com/ojsung/basic_stats_calculator/pkg/matrix"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+const defaultLogisticIterations = 50
+
+// IRLS stops once |D - D_previous| / (|D| + 0.1) falls below this, the rule R's glm uses with a far smaller tolerance.
+// The deviance is quadratic near its minimum, so this leaves the coefficients good to about 30 digits
+var logisticTolerance = bu.StrToFloat("1e-60")
+
+// A fitted probability this close to 0 or 1 means some combination of the predictors (nearly) separates the outcomes
+var separationTolerance = bu.StrToFloat("1e-15")
+
+// LogisticOptions caps the number of IRLS iterations (50 when left at zero)
+type LogisticOptions struct {
+	MaxIterations int
+}
+
+// LogisticFit is a binomial GLM with the logit link, ln(π / (1 - π)) = b0 + b1 x_1 + ... + b_k x_k. Coefficients start
+// with the intercept. ZStatistics are the Wald statistics b / SE and PValues test each coefficient against zero,
+// two-tailed, on the standard normal. Deviance is -2 ln L and NullDeviance the same for the intercept-only model, so
+// NullDeviance - Deviance is the likelihood-ratio statistic for every slope at once; AIC is Deviance + 2p.
+// Deviances holds the deviance after each iteration. Warnings explains a fit that did not converge or that separates
+// the outcomes, where the coefficients and standard errors run off towards infinity and should not be reported
+type LogisticFit struct {
+	Coefficients     []*big.Float
+	StandardErrors   []*big.Float
+	ZStatistics      []*big.Float
+	PValues          []*big.Float
+	Covariance       *matrix.BigMatrix[*big.Float]
+	Deviance         *big.Float
+	NullDeviance     *big.Float
+	AIC              *big.Float
+	DegreesOfFreedom int64
+	Probabilities    []*big.Float
+	Iterations       int
+	Converged        bool
+	Deviances        []*big.Float
+	Warnings         []string
+}
+
+// Logistic fits the probability that y = 1 from the predictors in x[i], by iteratively reweighted least squares.
+// Every y must be 0 or 1
+func Logistic[T op.Number | op.BigNumber](x [][]T, y []T, options ...LogisticOptions) (fit LogisticFit, err error) {
+	if len(x) != len(y) {
+		return LogisticFit{}, errors.New("regression needs the same number of x and y observations")
+	}
+	design, err := designMatrix(x)
+	if err != nil {
+		return LogisticFit{}, err
+	}
+	n, p := len(design), len(design[0])
+	if n <= p {
+		return LogisticFit{}, errors.New("regression needs more observations than coefficients")
+	}
+	outcomes := make([]bool, n)
+	successes := int64(0)
+	for i, value := range y {
+		float, err := op.ToBigFloat(value)
+		if err != nil {
+			return LogisticFit{}, err
+		}
+		if !float.IsInt() || (float.Sign() != 0 && float.Cmp(bu.StrToFloat("1")) != 0) {
+			return LogisticFit{}, errors.New("logistic regression needs every y to be 0 or 1")
+		}
+		outcomes[i] = float.Sign() != 0
+		if outcomes[i] {
+			successes++
+		}
+	}
+	maxIterations := defaultLogisticIterations
+	if len(options) > 0 && options[0].MaxIterations > 0 {
+		maxIterations = options[0].MaxIterations
+	}
+
+	beta := make([]*big.Float, p)
+	for j := range beta {
+		beta[j] = bu.PrecFloat().SetInt64(0)
+	}
+	linear := linearPredictor(design, beta)
+	deviance, err := logisticDeviance(linear, outcomes)
+	if err != nil {
+		return LogisticFit{}, err
+	}
+	var inverse *matrix.BigMatrix[*big.Float]
+	for fit.Iterations < maxIterations {
+		fit.Iterations++
+		beta, inverse, err = irlsStep(design, linear, outcomes)
+		if err != nil {
+			return LogisticFit{}, err
+		}
+		linear = linearPredictor(design, beta)
+		previous := deviance
+		if deviance, err = logisticDeviance(linear, outcomes); err != nil {
+			return LogisticFit{}, err
+		}
+		fit.Deviances = append(fit.Deviances, deviance)
+		change := bu.PrecFloat().Abs(bu.PrecFloat().Sub(deviance, previous))
+		scale := bu.PrecFloat().Add(bu.PrecFloat().Abs(deviance), bu.StrToFloat("0.1"))
+		if change.Cmp(scale.Mul(scale, logisticTolerance)) <= 0 {
+			fit.Converged = true
+			break
+		}
+	}
+	// Each step's information matrix belongs to the coefficients it started from. Once converged those match the final
+	// coefficients to working precision; otherwise it is recomputed at the final ones
+	if !fit.Converged {
+		if _, inverse, err = irlsStep(design, linear, outcomes); err != nil {
+			return LogisticFit{}, err
+		}
+	}
+
+	fit.Coefficients = beta
+	fit.Covariance = inverse
+	covarianceRows := inverse.GetRows()
+	fit.StandardErrors = make([]*big.Float, p)
+	fit.ZStatistics = make([]*big.Float, p)
+	fit.PValues = make([]*big.Float, p)
+	zero, one := bu.PrecFloat().SetInt64(0), bu.PrecFloat().SetInt64(1)
+	for j := range p {
+		fit.StandardErrors[j] = bu.PrecFloat().Sqrt(covarianceRows[j][j])
+		fit.ZStatistics[j] = bu.PrecFloat().Quo(beta[j], fit.StandardErrors[j])
+		pValue, err := calculator.NormalPValue(fit.ZStatistics[j], zero, one, "two")
+		if err != nil {
+			return LogisticFit{}, err
+		}
+		fit.PValues[j] = &pValue
+	}
+	fit.Deviance = deviance
+	fit.AIC = bu.PrecFloat().Add(deviance, bu.PrecFloat().SetInt64(int64(2*p)))
+	fit.DegreesOfFreedom = int64(n - p)
+	if fit.NullDeviance, err = nullDeviance(successes, int64(n)); err != nil {
+		return LogisticFit{}, err
+	}
+
+	separated := false
+	upper := bu.PrecFloat().Sub(one, separationTolerance)
+	fit.Probabilities = make([]*big.Float, n)
+	for i, eta := range linear {
+		fit.Probabilities[i], _ = logisticProbabilities(eta)
+		if fit.Probabilities[i].Cmp(separationTolerance) < 0 || fit.Probabilities[i].Cmp(upper) > 0 {
+			separated = true
+		}
+	}
+	if !fit.Converged {
+		fit.Warnings = append(fit.Warnings, fmt.Sprintf(
+			"the fit did not converge in %d iterations, so the coefficients are not the maximum likelihood estimates", fit.Iterations))
+	}
+	if separated {
+		fit.Warnings = append(fit.Warnings,
+			"some fitted probabilities are numerically 0 or 1, which suggests the predictors (quasi-)completely separate the outcomes; the maximum likelihood estimates may not exist and the standard errors and Wald tests are unreliable")
+	}
+	return fit, nil
+}
+
+// irlsStep is one Newton step for the log-likelihood, written as weighted least squares. With π = 1 / (1 + e^-η) and
+// weights w = π(1 - π), it regresses the working response z = η + (y - π) / w on X, solving (X^T W X) β = X^T W z.
+// (X^T W X)^-1 is the inverse of the Fisher information, the covariance of β
+func irlsStep(design [][]*big.Float, linear []*big.Float, outcomes []bool) (beta []*big.Float, inverse *matrix.BigMatrix[*big.Float], err error) {
+	weightedDesign := make([][]*big.Float, len(design))
+	weightedResponse := make([][]*big.Float, len(design))
+	for i, row := range design {
+		probability, complement := logisticProbabilities(linear[i])
+		weight := bu.PrecFloat().Mul(probability, complement)
+		// y - π is the complement of π for a success and -π for a failure
+		residual := bu.PrecFloat().Neg(probability)
+		if outcomes[i] {
+			residual = complement
+		}
+		working := bu.PrecFloat().Add(linear[i], bu.PrecFloat().Quo(residual, weight))
+		root := bu.PrecFloat().Sqrt(weight)
+		weightedDesign[i] = make([]*big.Float, len(row))
+		for j, value := range row {
+			weightedDesign[i][j] = bu.PrecFloat().Mul(root, value)
+		}
+		weightedResponse[i] = []*big.Float{working.Mul(working, root)}
+	}
+	x, err := matrix.NewBigMatrix(weightedDesign)
+	if err != nil {
+		return nil, nil, err
+	}
+	z, err := matrix.NewBigMatrix(weightedResponse)
+	if err != nil {
+		return nil, nil, err
+	}
+	transpose := x.Transpose()
+	information, err := transpose.Mul(x)
+	if err != nil {
+		return nil, nil, err
+	}
+	inverse, isSingular, err := information.Inverse()
+	if isSingular {
+		return nil, nil, errors.New("logistic regression predictors are collinear, or the fitted probabilities have reached 0 or 1, so the coefficients are not unique")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	projection, err := transpose.Mul(z)
+	if err != nil {
+		return nil, nil, err
+	}
+	solution, err := inverse.Mul(projection)
+	if err != nil {
+		return nil, nil, err
+	}
+	beta, err = solution.GetColumn(0)
+	return beta, inverse, err
+}
+
+func linearPredictor(design [][]*big.Float, beta []*big.Float) []*big.Float {
+	linear := make([]*big.Float, len(design))
+	for i, row := range design {
+		linear[i] = bu.PrecFloat().SetInt64(0)
+		for j, value := range row {
+			linear[i].Add(linear[i], bu.PrecFloat().Mul(value, beta[j]))
+		}
+	}
+	return linear
+}
+
+// logisticProbabilities returns π = 1 / (1 + e^-η) and 1 - π = 1 / (1 + e^η), each computed directly so that neither
+// loses its relative precision when the other is close to 1
+func logisticProbabilities(eta *big.Float) (probability, complement *big.Float) {
+	one := bu.StrToFloat("1")
+	probability = bu.PrecFloat().Quo(one, bu.PrecFloat().Add(one, calculator.Exp(bu.PrecFloat().Neg(eta))))
+	complement = bu.PrecFloat().Quo(one, bu.PrecFloat().Add(one, calculator.Exp(eta)))
+	return probability, complement
+}
+
+// logisticDeviance returns D = -2 Σ ln π_i over the successes plus ln(1 - π_i) over the failures
+func logisticDeviance(linear []*big.Float, outcomes []bool) (*big.Float, error) {
+	deviance := bu.PrecFloat().SetInt64(0)
+	for i, eta := range linear {
+		probability, complement := logisticProbabilities(eta)
+		if !outcomes[i] {
+			probability = complement
+		}
+		logProbability, err := calculator.Ln(probability)
+		if err != nil {
+			return nil, err
+		}
+		deviance.Sub(deviance, logProbability)
+	}
+	return deviance.Mul(deviance, bu.StrToFloat("2")), nil
+}
+
+// nullDeviance is the deviance of the intercept-only model, where every π is the proportion of successes k / n
+func nullDeviance(successes, n int64) (*big.Float, error) {
+	deviance := bu.PrecFloat().SetInt64(0)
+	for _, count := range []int64{successes, n - successes} {
+		if count == 0 {
+			continue
+		}
+		proportion := bu.PrecFloat().Quo(bu.PrecFloat().SetInt64(count), bu.PrecFloat().SetInt64(n))
+		logProportion, err := calculator.Ln(proportion)
+		if err != nil {
+			return nil, err
+		}
+		deviance.Sub(deviance, logProportion.Mul(logProportion, bu.PrecFloat().SetInt64(count)))
+	}
+	return deviance.Mul(deviance, bu.StrToFloat("2")), nil
+}
@@ -0,0 +1,79 @@
+package regression
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Logistic(t *testing.T) {
+	hours := []string{"0.50", "0.75", "1.00", "1.25", "1.50", "1.75", "1.75", "2.00", "2.25", "2.50", "2.75", "3.00", "3.25", "3.50", "4.00", "4.25", "4.50", "4.75", "5.00", "5.50"}
+	passed := []int64{0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1}
+	x := make([][]*big.Float, len(hours))
+	y := make([]*big.Float, len(passed))
+	for i := range hours {
+		x[i] = []*big.Float{bu.StrToFloat(hours[i])}
+		y[i] = bu.PrecFloat().SetInt64(passed[i])
+	}
+	fit, err := Logistic(x, y)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !fit.Converged || len(fit.Warnings) != 0 {
+		t.Errorf("Logistic() converged = %v with warnings %v, want a clean fit", fit.Converged, fit.Warnings)
+	}
+	if len(fit.Deviances) != fit.Iterations {
+		t.Errorf("Logistic() recorded %d deviances over %d iterations", len(fit.Deviances), fit.Iterations)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"-4.077713431087630670085346288916", "1.504645428373333456061253871822"})
+	compareAll(t, "standard errors", fit.StandardErrors, []string{"1.760994314156470990674578209850", "0.628720845945385828293002999500"})
+	compareAll(t, "z statistics", fit.ZStatistics, []string{"-2.315574444680069786270485068995", "2.393185207833931569573790644989"})
+	compareAll(t, "p-values", fit.PValues, []string{"0.020581515512458574391919970376", "0.016702807340367925933778884438"})
+	compareAll(t, "covariance", []*big.Float{fit.Covariance.GetRows()[0][1]}, []string{"-1.034503458983419415933943572757"})
+	compareAll(t, "deviance", []*big.Float{fit.Deviance, fit.NullDeviance, fit.AIC}, []string{
+		"16.059756928689348585283595509876", "27.725887222397812376689284858327", "20.059756928689348585283595509876",
+	})
+	compareAll(t, "probabilities", []*big.Float{fit.Probabilities[0], fit.Probabilities[19]}, []string{"0.034710335976879439053106304072", "0.985194442744520383909211807059"})
+	if fit.DegreesOfFreedom != 18 {
+		t.Errorf("Logistic() degrees of freedom = %d, want 18", fit.DegreesOfFreedom)
+	}
+}
+
+func Test_LogisticSeparation(t *testing.T) {
+	fit, err := Logistic([][]int{{1}, {2}, {3}, {4}, {5}, {6}}, []int{0, 0, 0, 1, 1, 1}, LogisticOptions{MaxIterations: 25})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fit.Converged || fit.Iterations != 25 {
+		t.Errorf("Logistic() converged = %v after %d iterations, want no convergence after 25", fit.Converged, fit.Iterations)
+	}
+	if len(fit.Warnings) != 2 || !strings.Contains(fit.Warnings[0], "did not converge") || !strings.Contains(fit.Warnings[1], "separate") {
+		t.Errorf("Logistic() warnings = %v, want convergence and separation warnings", fit.Warnings)
+	}
+}
+
+func Test_LogisticErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		x    [][]int
+		y    []int
+	}{
+		{"It should reject an outcome other than 0 or 1", [][]int{{1}, {2}, {3}}, []int{0, 2, 1}},
+		{"It should reject mismatched lengths", [][]int{{1}, {2}, {3}}, []int{0, 1}},
+		{"It should reject too few observations", [][]int{{1}, {2}}, []int{0, 1}},
+		{"It should reject collinear predictors", [][]int{{1, 2}, {2, 4}, {3, 6}, {4, 8}}, []int{0, 1, 0, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Logistic(tt.x, tt.y); err == nil {
+				t.Errorf("Logistic() expected an error")
+			}
+		})
+	}
+	if _, err := Logistic([][]float64{{1}, {2}, {3}, {4}}, []float64{0, math.NaN(), 1, 1}); err == nil {
+		t.Errorf("Logistic() expected an error for a NaN outcome")
+	}
+}
@@ -0,0 +1,39 @@
+package regression
+
+import (
+	"errors"
+	"math/big"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+	op "github.com/ojsung/basic_stats_calculator/pkg/operand"
+)
+
+// Polynomial fits y = b0 + b1 x + b2 x² + ... + b_d x^d through the Vandermonde design matrix, whose columns are the
+// powers of x. Those columns grow more alike with every degree, so high degrees over x far from zero are where the
+// ill-conditioning warning tends to appear
+func Polynomial[T op.Number | op.BigNumber](x, y []T, degree int, options ...LeastSquaresOptions) (fit LinearFit, err error) {
+	if degree < 1 {
+		return LinearFit{}, errors.New("polynomial regression degree must be at least 1")
+	}
+	if len(x) != len(y) {
+		return LinearFit{}, errors.New("regression needs the same number of x and y observations")
+	}
+	design := make([][]*big.Float, len(x))
+	for i, value := range x {
+		design[i] = make([]*big.Float, degree+1)
+		design[i][0] = bu.PrecFloat().SetInt64(1)
+		power, err := op.ToBigFloat(value)
+		if err != nil {
+			return LinearFit{}, err
+		}
+		for j := 1; j <= degree; j++ {
+			design[i][j] = bu.PrecFloat().Set(power)
+			power.Mul(power, design[i][1])
+		}
+	}
+	response, err := op.ToBigFloats(y)
+	if err != nil {
+		return LinearFit{}, err
+	}
+	return leastSquares(design, response, options)
+}
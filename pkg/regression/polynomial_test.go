@@ -0,0 +1,63 @@
+package regression
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	bu "github.com/ojsung/basic_stats_calculator/internal/big_utils"
+)
+
+func Test_Polynomial(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5, 6, 7, 8}
+	y := []float64{1.5, 4.25, 9.5, 16, 26.25, 35.5, 49.75, 64}
+	fit, err := Polynomial(x, y, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"0.334821428571428571428571428571", "0.061011904761904761904761904762", "0.989583333333333333333333333333"})
+	covariance := fit.Covariance.GetRows()
+	compareAll(t, "covariance", []*big.Float{covariance[0][0], covariance[0][1], covariance[1][0], covariance[2][2]}, []string{
+		"0.750910129676870748299319727891", "-0.351343271683673469387755102041", "-0.351343271683673469387755102041", "0.002296361252834467120181405896",
+	})
+	compareAll(t, "summary", []*big.Float{fit.RSquared, fit.AdjustedRSquared}, []string{"0.999455676132652933748174257964", "0.999237946585714107247443961149"})
+	if compare := bu.NewCompare(fit.ConditionNumber, "30265.714285714285714285714286"); !compare.Equal() {
+		t.Errorf("Polynomial() condition number = %v, want %v", compare.ActualAsString, compare.Expected)
+	}
+	if len(fit.Warnings) != 0 {
+		t.Errorf("Polynomial() warned about a well-conditioned fit: %v", fit.Warnings)
+	}
+}
+
+func Test_PolynomialIllConditioned(t *testing.T) {
+	// The same points measured in calendar years make x, x² and x³ nearly collinear
+	x := []float64{1990, 1991, 1992, 1993, 1994, 1995, 1996, 1997}
+	y := []float64{1.5, 4.25, 9.5, 16, 26.25, 35.5, 49.75, 64}
+	fit, err := Polynomial(x, y, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fit.Warnings) != 1 || !strings.Contains(fit.Warnings[0], "ill-conditioned") {
+		t.Errorf("Polynomial() warnings = %v, want an ill-conditioning warning", fit.Warnings)
+	}
+	compareAll(t, "coefficients", fit.Coefficients, []string{"58930309.7321428571428571", "-86728.9841269841269841269841", "42.5208333333333333333333333", "-0.00694444444444444444444444444"})
+}
+
+func Test_PolynomialErrors(t *testing.T) {
+	if _, err := Polynomial([]int{1, 2, 3}, []int{1, 4, 9}, 0); err == nil {
+		t.Errorf("Polynomial() expected an error for degree 0")
+	}
+	if _, err := Polynomial([]int{1, 2, 3}, []int{1, 4, 9}, 2); err == nil {
+		t.Errorf("Polynomial() expected an error without more observations than coefficients")
+	}
+	if _, err := Polynomial([]int{}, []int{}, 1); err == nil {
+		t.Errorf("Polynomial() expected an error for no observations")
+	}
+	if _, err := Polynomial([]float64{1, 2, math.Inf(-1), 4}, []float64{1, 4, 9, 16}, 1); err == nil {
+		t.Errorf("Polynomial() expected an error for an infinite x")
+	}
+	if _, err := Polynomial([]float64{1, 2, 3, 4}, []float64{1, math.NaN(), 9, 16}, 1); err == nil {
+		t.Errorf("Polynomial() expected an error for a NaN y")
+	}
+}